@@ -8,10 +8,15 @@ import (
 // BeginBlocker check for infraction evidence or downtime of validators
 // on every begin block
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
-// 	TODO: fill out if your application requires beginblock, if not you can delete this function
+	// 	TODO: fill out if your application requires beginblock, if not you can delete this function
 }
 
-// EndBlocker called every block, process inflation, update validator set.
+// EndBlocker releases the names whose grace period is over at this height,
+// clears the premium of names released long enough ago, refunds the offers
+// expiring at this height and takes expired listings off the market
 func EndBlocker(ctx sdk.Context, k Keeper) {
-// 	TODO: fill out if your application requires endblock, if not you can delete this function
+	k.ReleaseExpiredNames(ctx, ctx.BlockHeight())
+	k.PruneReleases(ctx, ctx.BlockHeight())
+	k.RefundExpiredOffers(ctx, ctx.BlockHeight())
+	k.ExpireListings(ctx, ctx.BlockHeight())
 }
@@ -12,26 +12,71 @@ const (
 )
 
 var (
-	NewKeeper         = keeper.NewKeeper
-	NewQuerier        = keeper.NewQuerier
-	NewMsgBuyName     = types.NewMsgBuyName
-	NewMsgSetName     = types.NewMsgSetName
-	NewMsgDeleteName  = types.NewMsgDeleteName
-	NewWhois          = types.NewWhois
-	NewMsgSetSale     = types.NewMsgSetSale
-	ModuleCdc         = types.ModuleCdc
-	RegisterCodec     = types.RegisterCodec
-	DefaultParamspace = types.DefaultParamspace
+	NewKeeper              = keeper.NewKeeper
+	NewQuerier             = keeper.NewQuerier
+	NewMsgBuyName          = types.NewMsgBuyName
+	NewMsgSetName          = types.NewMsgSetName
+	NewMsgDeleteName       = types.NewMsgDeleteName
+	NewWhois               = types.NewWhois
+	NewMsgSetSale          = types.NewMsgSetSale
+	NewMsgSetDutchSale     = types.NewMsgSetDutchSale
+	NewMsgSetAuctionSale   = types.NewMsgSetAuctionSale
+	NewMsgSetPrivateSale   = types.NewMsgSetPrivateSale
+	NewMsgSetBundleSale    = types.NewMsgSetBundleSale
+	NewMsgRenewName        = types.NewMsgRenewName
+	NewMsgCreateSubdomain  = types.NewMsgCreateSubdomain
+	NewMsgUpdateSubdomain  = types.NewMsgUpdateSubdomain
+	NewMsgRevokeSubdomain  = types.NewMsgRevokeSubdomain
+	NewMsgSetRecord        = types.NewMsgSetRecord
+	NewMsgClearRecord      = types.NewMsgClearRecord
+	NewMsgSetPrimaryName   = types.NewMsgSetPrimaryName
+	NewMsgCommitBid        = types.NewMsgCommitBid
+	NewMsgProxyBid         = types.NewMsgProxyBid
+	NewMsgRevealBid        = types.NewMsgRevealBid
+	NewMsgCancelSale       = types.NewMsgCancelSale
+	NewMsgWithdrawBid      = types.NewMsgWithdrawBid
+	NewMsgMakeOffer        = types.NewMsgMakeOffer
+	NewMsgAcceptOffer      = types.NewMsgAcceptOffer
+	NewMsgSwapNames        = types.NewMsgSwapNames
+	NewMsgBuyBundle        = types.NewMsgBuyBundle
+	NewOffer               = types.NewOffer
+	NewReserveNameProposal = types.NewReserveNameProposal
+	NewSeizeNameProposal   = types.NewSeizeNameProposal
+	DefaultParams          = types.DefaultParams
+	ValidateName           = types.ValidateName
+	ModuleCdc              = types.ModuleCdc
+	RegisterCodec          = types.RegisterCodec
+	DefaultParamspace      = types.DefaultParamspace
 )
 
 type (
-	Keeper          = keeper.Keeper
-	MsgSetName      = types.MsgSetName
-	MsgBuyName      = types.MsgBuyName
-	MsgDeleteName   = types.MsgDeleteName
-	MsgSetSale      = types.MsgSetSale
-	QueryResResolve = types.QueryResResolve
-	QueryResNames   = types.QueryResNames
-	Whois           = types.Whois
-	QuerySaleStaus  = types.QuerySaleStatus
+	Keeper              = keeper.Keeper
+	MsgSetName          = types.MsgSetName
+	MsgBuyName          = types.MsgBuyName
+	MsgDeleteName       = types.MsgDeleteName
+	MsgSetSale          = types.MsgSetSale
+	MsgRenewName        = types.MsgRenewName
+	MsgCreateSubdomain  = types.MsgCreateSubdomain
+	MsgUpdateSubdomain  = types.MsgUpdateSubdomain
+	MsgRevokeSubdomain  = types.MsgRevokeSubdomain
+	MsgSetRecord        = types.MsgSetRecord
+	MsgClearRecord      = types.MsgClearRecord
+	MsgSetPrimaryName   = types.MsgSetPrimaryName
+	MsgRevealBid        = types.MsgRevealBid
+	MsgCancelSale       = types.MsgCancelSale
+	MsgWithdrawBid      = types.MsgWithdrawBid
+	MsgMakeOffer        = types.MsgMakeOffer
+	MsgAcceptOffer      = types.MsgAcceptOffer
+	MsgSwapNames        = types.MsgSwapNames
+	MsgBuyBundle        = types.MsgBuyBundle
+	Offer               = types.Offer
+	QueryResOffers      = types.QueryResOffers
+	ReserveNameProposal = types.ReserveNameProposal
+	SeizeNameProposal   = types.SeizeNameProposal
+	Records             = types.Records
+	Params              = types.Params
+	QueryResResolve     = types.QueryResResolve
+	QueryResNames       = types.QueryResNames
+	Whois               = types.Whois
+	QuerySaleStaus      = types.QuerySaleStatus
 )
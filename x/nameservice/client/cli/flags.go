@@ -0,0 +1,21 @@
+package cli
+
+const (
+	FlagRecordType    = "type"
+	FlagRecordKey     = "key"
+	FlagLimit         = "limit"
+	FlagStartAfter    = "start-after"
+	FlagPrefix        = "prefix"
+	FlagSaleType      = "sale-type"
+	FlagReverse       = "reverse"
+	FlagSealedBid     = "sealed-bid"
+	FlagSalt          = "salt"
+	FlagFloorPrice    = "floor-price"
+	FlagDuration      = "duration"
+	FlagReserve       = "reserve-price"
+	FlagHideReserve   = "hide-reserve"
+	FlagMaxBid        = "max-bid"
+	FlagAllowed       = "allowed-buyers"
+	FlagListingExpiry = "listing-expiry"
+	FlagBundle        = "bundle"
+)
@@ -7,8 +7,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
@@ -24,6 +26,12 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdWhois(storeKey, cdc),
 		GetCmdNames(storeKey, cdc),
 		GetCmdSaleStatus(storeKey, cdc),
+		GetCmdPriceQuote(storeKey, cdc),
+		GetCmdSubdomains(storeKey, cdc),
+		GetCmdReverse(storeKey, cdc),
+		GetCmdNamesByOwner(storeKey, cdc),
+		GetCmdParams(storeKey, cdc),
+		GetCmdOffers(storeKey, cdc),
 	)...)
 
 	return nameserviceQueryCmd
@@ -31,15 +39,23 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdResolveName queries information about a name
 func GetCmdResolveName(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "resolve [name]",
-		Short: "resolve name",
+		Short: "resolve name, or one of its typed records with --type and --key",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			name := args[0]
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/resolve/%s", queryRoute, name), nil)
+			route := fmt.Sprintf("custom/%s/resolve/%s", queryRoute, name)
+			if recordType := viper.GetString(FlagRecordType); recordType != "" {
+				route = fmt.Sprintf("%s/%s", route, recordType)
+				if key := viper.GetString(FlagRecordKey); key != "" {
+					route = fmt.Sprintf("%s/%s", route, key)
+				}
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
 			if err != nil {
 				fmt.Printf("could not resolve name - %s \n", name)
 				return nil
@@ -50,6 +66,11 @@ func GetCmdResolveName(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	cmd.Flags().String(FlagRecordType, "", "record type to resolve (address|text|contenthash|alias)")
+	cmd.Flags().String(FlagRecordKey, "", "key of the record, the bech32 prefix for addresses")
+
+	return cmd
 }
 
 // GetCmdWhois queries information about a domain
@@ -75,26 +96,44 @@ func GetCmdWhois(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
-// GetCmdNames queries a list of all names
+// GetCmdNames queries a page of names
 func GetCmdNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "names",
-		Short: "names",
-		// Args:  cobra.ExactArgs(1),
+		Short: "Query names, paginated with --limit and --start-after and filtered with --prefix and --sale-type",
+		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names", queryRoute), nil)
+			params := types.NewQueryNamesParams(
+				viper.GetString(FlagStartAfter),
+				viper.GetInt(FlagLimit),
+				viper.GetString(FlagPrefix),
+				viper.GetIntSlice(FlagSaleType),
+				viper.GetBool(FlagReverse),
+			)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names", queryRoute), bz)
 			if err != nil {
 				fmt.Printf("could not get query names\n")
 				return nil
 			}
 
-			var out types.QueryResNames
+			var out types.QueryResNamesPage
 			cdc.MustUnmarshalJSON(res, &out)
 			return cliCtx.PrintOutput(out)
 		},
 	}
+	cmd.Flags().Int(FlagLimit, types.DefaultQueryLimit, "maximum number of names to return")
+	cmd.Flags().String(FlagStartAfter, "", "return names after this one, the next cursor of the previous page")
+	cmd.Flags().String(FlagPrefix, "", "only return names starting with this prefix")
+	cmd.Flags().IntSlice(FlagSaleType, nil, "only return names with one of these sale types (0: not for sale, 1: normal, 2: auction, 3: sealed bid auction, 4: second price auction, 5: dutch auction)")
+	cmd.Flags().Bool(FlagReverse, false, "sort names in descending order")
+	return cmd
 }
 
 // GetCmdSaleStatus queries sale information about a domain
@@ -119,3 +158,153 @@ func GetCmdSaleStatus(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdPriceQuote queries the price a buyer currently pays for a name
+func GetCmdPriceQuote(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-quote [name]",
+		Short: "Query the current price of a name, including the premium of a recently released name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/price_quote/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("could not quote price - %s \n", name)
+				return nil
+			}
+
+			var out types.QueryResPriceQuote
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdSubdomains queries the subdomains issued under a name
+func GetCmdSubdomains(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subdomains [name]",
+		Short: "Query the subdomains issued under a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/subdomains/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("could not get subdomains - %s \n", name)
+				return nil
+			}
+
+			var out types.QueryResNames
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdOffers queries the open offers on a name
+func GetCmdOffers(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "offers [name]",
+		Short: "Query the open offers on a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/offers/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("could not get offers - %s \n", name)
+				return nil
+			}
+
+			var out types.QueryResOffers
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdReverse queries the primary name of an address
+func GetCmdReverse(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reverse [address]",
+		Short: "Query the primary name of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			addr := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/reverse/%s", queryRoute, addr), nil)
+			if err != nil {
+				fmt.Printf("could not resolve address - %s \n", addr)
+				return nil
+			}
+
+			var out types.QueryResReverse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdNamesByOwner queries a page of the names owned by an address
+func GetCmdNamesByOwner(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "names-by-owner [address]",
+		Short: "Query the names owned by an address, paginated with --limit and --start-after",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryNamesByOwnerParams(owner, viper.GetString(FlagStartAfter), viper.GetInt(FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names_by_owner", queryRoute), bz)
+			if err != nil {
+				fmt.Printf("could not get names of owner - %s \n", args[0])
+				return nil
+			}
+
+			var out types.QueryResNamesPage
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	cmd.Flags().Int(FlagLimit, types.DefaultQueryLimit, "maximum number of names to return")
+	cmd.Flags().String(FlagStartAfter, "", "return names after this one, the next cursor of the previous page")
+	return cmd
+}
+
+// GetCmdParams queries the nameservice parameters
+func GetCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current nameservice parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params", queryRoute), nil)
+			if err != nil {
+				fmt.Printf("could not get params\n")
+				return nil
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
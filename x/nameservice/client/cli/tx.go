@@ -2,9 +2,12 @@ package cli
 
 import (
 	"bufio"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -13,6 +16,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
 )
 
@@ -30,6 +35,20 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdSetName(cdc),
 		GetCmdDeleteName(cdc),
 		GetCmdSetSale(cdc),
+		GetCmdRenewName(cdc),
+		GetCmdCreateSubdomain(cdc),
+		GetCmdUpdateSubdomain(cdc),
+		GetCmdRevokeSubdomain(cdc),
+		GetCmdSetRecord(cdc),
+		GetCmdClearRecord(cdc),
+		GetCmdSetPrimaryName(cdc),
+		GetCmdRevealBid(cdc),
+		GetCmdCancelSale(cdc),
+		GetCmdWithdrawBid(cdc),
+		GetCmdMakeOffer(cdc),
+		GetCmdAcceptOffer(cdc),
+		GetCmdSwapNames(cdc),
+		GetCmdBuyBundle(cdc),
 	)...)
 
 	return nameserviceTxCmd
@@ -37,9 +56,9 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdBuyName is the CLI command for sending a BuyName transaction
 func GetCmdBuyName(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "buy-name [name] [amount]",
-		Short: "bid for existing name or claim new name",
+		Short: "bid for existing name or claim new name, on a sealed bid auction the amount is the deposit of the --sealed-bid",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -53,6 +72,23 @@ func GetCmdBuyName(cdc *codec.Codec) *cobra.Command {
 			}
 
 			msg := types.NewMsgBuyName(args[0], coins, cliCtx.GetFromAddress())
+			// A sealed bid only goes out as its commitment, to be revealed with the same salt
+			if sealedBid := viper.GetString(FlagSealedBid); sealedBid != "" {
+				bid, err := sdk.ParseCoins(sealedBid)
+				if err != nil {
+					return err
+				}
+				commitment := types.SealedBidCommitment(args[0], cliCtx.GetFromAddress(), bid, viper.GetString(FlagSalt))
+				msg = types.NewMsgCommitBid(args[0], coins, commitment, cliCtx.GetFromAddress())
+			}
+			// A proxy bid is raised up to its maximum whenever it is outbid
+			if maxBid := viper.GetString(FlagMaxBid); maxBid != "" {
+				maxCoins, err := sdk.ParseCoins(maxBid)
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgProxyBid(args[0], coins, maxCoins, cliCtx.GetFromAddress())
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -61,6 +97,11 @@ func GetCmdBuyName(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().String(FlagSealedBid, "", "bid committed to a sealed bid auction, kept secret until revealed")
+	cmd.Flags().String(FlagSalt, "", "secret salt of the sealed bid, needed to reveal it")
+	cmd.Flags().String(FlagMaxBid, "", "maximum price an open auction bid is automatically raised up to when outbid")
+
+	return cmd
 }
 
 // GetCmdSetName is the CLI command for sending a SetName transaction
@@ -115,9 +156,9 @@ func GetCmdDeleteName(cdc *codec.Codec) *cobra.Command {
 
 // GetCmdSetSale is the CLI command for sending a SetSale transaction
 func GetCmdSetSale(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "set-sale [name] [type] [price]",
-		Short: "set the type and price of the sale for the name you own",
+		Short: "set the type (0: not for sale, 1: normal, 2: auction, 3: sealed bid auction, 4: second price auction, 5: dutch auction starting at the price) and price of the sale for the name you own",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -138,6 +179,30 @@ func GetCmdSetSale(cdc *codec.Codec) *cobra.Command {
 			}
 
 			msg := types.NewMsgSetSale(cliCtx.GetFromAddress(), args[0], saleType, coins)
+			if saleType == types.SaleTypeDutchAuction {
+				floorPrice, err := sdk.ParseCoins(viper.GetString(FlagFloorPrice))
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgSetDutchSale(cliCtx.GetFromAddress(), args[0], coins, floorPrice, viper.GetInt64(FlagDuration))
+			}
+			if reserve := viper.GetString(FlagReserve); reserve != "" {
+				reservePrice, err := sdk.ParseCoins(reserve)
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgSetAuctionSale(cliCtx.GetFromAddress(), args[0], saleType, coins, reservePrice, viper.GetBool(FlagHideReserve))
+			}
+			// A private sale is only open to the allowed buyers
+			for _, allowed := range viper.GetStringSlice(FlagAllowed) {
+				buyer, err := sdk.AccAddressFromBech32(allowed)
+				if err != nil {
+					return err
+				}
+				msg.AllowedBuyers = append(msg.AllowedBuyers, buyer)
+			}
+			msg.ListingExpiry = viper.GetInt64(FlagListingExpiry)
+			msg.Bundle = viper.GetStringSlice(FlagBundle)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -147,4 +212,464 @@ func GetCmdSetSale(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().String(FlagFloorPrice, "", "lowest price a dutch auction falls to")
+	cmd.Flags().Int64(FlagDuration, 0, "blocks over which the price of a dutch auction falls to its floor")
+	cmd.Flags().String(FlagReserve, "", "lowest price an auction sells at")
+	cmd.Flags().Bool(FlagHideReserve, false, "keep the reserve price out of queries until the auction ends")
+	cmd.Flags().StringSlice(FlagAllowed, nil, "comma separated addresses a normal sale is restricted to, making it a private sale")
+	cmd.Flags().Int64(FlagListingExpiry, 0, "last height at which a normal sale is listed, after which the name goes off the market")
+	cmd.Flags().StringSlice(FlagBundle, nil, "comma separated names you own sold along with the name as one bundle, on a normal sale or an open auction")
+
+	return cmd
+}
+
+// GetCmdRenewName is the CLI command for sending a RenewName transaction
+func GetCmdRenewName(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "renew-name [name] [years]",
+		Short: "extend the registration of a name that you own by paying the yearly rent",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			years, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenewName(args[0], years, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCreateSubdomain is the CLI command for sending a CreateSubdomain transaction
+func GetCmdCreateSubdomain(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-subdomain [parent] [label] [value] [sub-owner]",
+		Short: "issue the subdomain label.parent of a name that you own to an owner",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			subOwner, err := sdk.AccAddressFromBech32(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSubdomain(args[0], args[1], args[2], subOwner, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUpdateSubdomain is the CLI command for sending an UpdateSubdomain transaction
+func GetCmdUpdateSubdomain(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-subdomain [name] [value] [sub-owner]",
+		Short: "set the value and owner of a subdomain of a name that you own",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			subOwner, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateSubdomain(args[0], args[1], subOwner, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevokeSubdomain is the CLI command for sending a RevokeSubdomain transaction
+func GetCmdRevokeSubdomain(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-subdomain [name]",
+		Short: "revoke a subdomain of a name that you own along with its own subdomains",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRevokeSubdomain(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetRecord is the CLI command for sending a SetRecord transaction
+func GetCmdSetRecord(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-record [name] [type] [value]",
+		Short: "set a typed record (address|text|contenthash|alias) of a name that you own",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetRecord(args[0], args[1], viper.GetString(FlagRecordKey), args[2], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagRecordKey, "", "key of the record, the bech32 prefix for addresses")
+
+	return cmd
+}
+
+// GetCmdClearRecord is the CLI command for sending a ClearRecord transaction
+func GetCmdClearRecord(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-record [name] [type]",
+		Short: "clear a typed record of a name that you own",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgClearRecord(args[0], args[1], viper.GetString(FlagRecordKey), cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagRecordKey, "", "key of the record, the bech32 prefix for addresses")
+
+	return cmd
+}
+
+// GetCmdSetPrimaryName is the CLI command for sending a SetPrimaryName transaction
+func GetCmdSetPrimaryName(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-primary-name [name]",
+		Short: "set a name that you own and that resolves to your address as the primary name of your address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetPrimaryName(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevealBid is the CLI command for sending a RevealBid transaction
+func GetCmdRevealBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal-bid [name] [bid] [salt]",
+		Short: "reveal the bid committed to a sealed bid auction with its salt",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			bid, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBid(args[0], bid, args[2], cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCancelSale is the CLI command for sending a CancelSale transaction
+func GetCmdCancelSale(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-sale [name]",
+		Short: "take a name you own off the market, paying a penalty on the highest bid if there are bids",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgCancelSale(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWithdrawBid is the CLI command for sending a WithdrawBid transaction
+func GetCmdWithdrawBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-bid [name]",
+		Short: "withdraw your outbid bids on an auction so that you are not asked to pay them should the leading bid fail",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgWithdrawBid(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdMakeOffer is the CLI command for sending a MakeOffer transaction
+func GetCmdMakeOffer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "make-offer [name] [amount] [expiry-height]",
+		Short: "escrow an offer for a name that is not for sale, refunded at the expiry height unless its owner accepts it",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMakeOffer(args[0], coins, expiryHeight, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdAcceptOffer is the CLI command for sending an AcceptOffer transaction
+func GetCmdAcceptOffer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept-offer [name] [buyer]",
+		Short: "sell a name that you own to the buyer of an offer, for the amount escrowed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			buyer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptOffer(args[0], buyer, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdBuyBundle is the CLI command for sending a BuyBundle transaction
+func GetCmdBuyBundle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "buy-bundle [name] [bundle] [amount]",
+		Short: "buy or bid for a name sold in a bundle along with the comma separated other names of the bundle, all of which are transferred together",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			coins, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyBundle(args[0], strings.Split(args[1], ","), coins, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSwapNames is the CLI command for generating a SwapNames transaction,
+// which the owners of both names sign in turn before it is broadcast
+func GetCmdSwapNames(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-names [name] [counter-name] [counter-owner] [payment]",
+		Short: "trade a name that you own for the name of another owner, optionally paying them the balance",
+		Long: `Trade a name that you own for the name of another owner, optionally paying them the balance.
+The transaction needs the signatures of both owners, so it is only generated here:
+
+$ nscli tx nameservice swap-names foo bar <counter-owner> 10nametoken --from <owner> --generate-only > swap.json
+$ nscli tx sign swap.json --from <owner> > swap-signed.json
+$ nscli tx sign swap-signed.json --from <counter-owner> > swap-both.json
+$ nscli tx broadcast swap-both.json`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if !cliCtx.GenerateOnly {
+				return fmt.Errorf("a swap is signed by both owners, generate it with --%s", flags.FlagGenerateOnly)
+			}
+
+			counterOwner, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			var payment sdk.Coins
+			if len(args) > 3 {
+				payment, err = sdk.ParseCoins(args[3])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSwapNames(args[0], cliCtx.GetFromAddress(), args[1], counterOwner, payment)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitReserveNameProposal is the CLI command for submitting a ReserveNameProposal
+func GetCmdSubmitReserveNameProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-names [name] [name...]",
+		Short: "Submit a proposal to reserve names so that they can no longer be registered",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewReserveNameProposal(viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription), args)
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// GetCmdSubmitSeizeNameProposal is the CLI command for submitting a SeizeNameProposal
+func GetCmdSubmitSeizeNameProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seize-name [name] [new-owner]",
+		Short: "Submit a proposal to hand a disputed name over to a new owner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSeizeNameProposal(viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription), args[0], newOwner)
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
 }
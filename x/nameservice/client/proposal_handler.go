@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/lpy-neo/nameservice/x/nameservice/client/cli"
+	"github.com/lpy-neo/nameservice/x/nameservice/client/rest"
+)
+
+// nameservice proposal handlers
+var (
+	ReserveNameProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitReserveNameProposal, rest.ReserveNameProposalRESTHandler)
+	SeizeNameProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitSeizeNameProposal, rest.SeizeNameProposalRESTHandler)
+)
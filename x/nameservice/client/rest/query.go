@@ -3,8 +3,11 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
 
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
@@ -16,7 +19,15 @@ func resolveNameHandler(cliCtx context.CLIContext, storeName string) http.Handle
 		vars := mux.Vars(r)
 		paramType := vars[restName]
 
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/resolve/%s", storeName, paramType), nil)
+		route := fmt.Sprintf("custom/%s/resolve/%s", storeName, paramType)
+		if recordType := r.URL.Query().Get(restRecordType); recordType != "" {
+			route = fmt.Sprintf("%s/%s", route, recordType)
+			if key := r.URL.Query().Get(restRecordKey); key != "" {
+				route = fmt.Sprintf("%s/%s", route, key)
+			}
+		}
+
+		res, _, err := cliCtx.QueryWithData(route, nil)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
@@ -43,7 +54,40 @@ func whoIsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc
 
 func namesHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names", storeName), nil)
+		query := r.URL.Query()
+
+		limit, ok := parseLimit(w, r)
+		if !ok {
+			return
+		}
+
+		var saleTypes []types.SaleType
+		for _, s := range query[restSaleType] {
+			saleType, err := strconv.Atoi(s)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			saleTypes = append(saleTypes, saleType)
+		}
+
+		reverse := false
+		if s := query.Get(restReverse); s != "" {
+			var err error
+			if reverse, err = strconv.ParseBool(s); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryNamesParams(query.Get(restStartAfter), int(limit), query.Get(restPrefix), saleTypes, reverse)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names", storeName), bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
@@ -66,3 +110,116 @@ func saleStausHandler(cliCtx context.CLIContext, storeName string) http.HandlerF
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func priceQuoteHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		paramType := vars[restName]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/price_quote/%s", storeName, paramType), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func offersHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		paramType := vars[restName]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/offers/%s", storeName, paramType), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func subdomainsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		paramType := vars[restName]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/subdomains/%s", storeName, paramType), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func reverseHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		paramType := vars[restAddress]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/reverse/%s", storeName, paramType), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func namesByOwnerHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		owner, err := sdk.AccAddressFromBech32(vars[restAddress])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		limit, ok := parseLimit(w, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryNamesByOwnerParams(owner, r.URL.Query().Get(restStartAfter), int(limit))
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names_by_owner", storeName), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// parseLimit parses the optional page size of a request, a missing one
+// leaving the default page size to the querier
+func parseLimit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	s := r.URL.Query().Get(restLimit)
+	if s == "" {
+		return 0, true
+	}
+	limit, ok := rest.ParseInt64OrReturnBadRequest(w, s)
+	return int(limit), ok
+}
+
+func paramsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params", storeName), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
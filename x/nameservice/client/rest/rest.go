@@ -9,7 +9,15 @@ import (
 )
 
 const (
-	restName = "name"
+	restName       = "name"
+	restAddress    = "address"
+	restRecordType = "type"
+	restRecordKey  = "key"
+	restLimit      = "limit"
+	restStartAfter = "start_after"
+	restPrefix     = "prefix"
+	restSaleType   = "sale_type"
+	restReverse    = "reverse"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
@@ -21,5 +29,25 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/whois", storeName, restName), whoIsHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/names", storeName), deleteNameHandler(cliCtx)).Methods("DELETE")
 	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/set_sale", storeName, restName), setSaleHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/reveal_bid", storeName, restName), revealBidHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/cancel_sale", storeName, restName), cancelSaleHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/withdraw_bid", storeName, restName), withdrawBidHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/make_offer", storeName, restName), makeOfferHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/accept_offer", storeName, restName), acceptOfferHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/offers", storeName, restName), offersHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/swap_names", storeName, restName), swapNamesHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/buy_bundle", storeName, restName), buyBundleHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/sale_status", storeName, restName), saleStausHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/renew_name", storeName, restName), renewNameHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/price_quote", storeName, restName), priceQuoteHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/subdomains", storeName, restName), subdomainsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/create_subdomain", storeName, restName), createSubdomainHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/update_subdomain", storeName, restName), updateSubdomainHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/revoke_subdomain", storeName, restName), revokeSubdomainHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/set_record", storeName, restName), setRecordHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/clear_record", storeName, restName), clearRecordHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/set_primary_name", storeName, restName), setPrimaryNameHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/reverse/{%s}", storeName, restAddress), reverseHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/owners/{%s}/names", storeName, restAddress), namesByOwnerHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/params", storeName), paramsHandler(cliCtx, storeName)).Methods("GET")
 }
@@ -7,24 +7,80 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// WhoisRecord pairs a whois with the name it is registered under
+type WhoisRecord struct {
+	Name  string `json:"name"`
+	Whois Whois  `json:"whois"`
+}
+
+// PrimaryNameRecord pairs an address with its primary name
+type PrimaryNameRecord struct {
+	Address sdk.AccAddress `json:"address"`
+	Name    string         `json:"name"`
+}
+
+// ReleaseRecord pairs a released name with the height it was released at,
+// from which its premium decays
+type ReleaseRecord struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+}
+
 type GenesisState struct {
-	WhoisRecords []Whois `json:"whois_records"`
+	Params        Params              `json:"params"`
+	WhoisRecords  []WhoisRecord       `json:"whois_records"`
+	PrimaryNames  []PrimaryNameRecord `json:"primary_names"`
+	ReservedNames []string            `json:"reserved_names"`
+	Offers        []Offer             `json:"offers"`
+	Releases      []ReleaseRecord     `json:"releases"`
 }
 
-func NewGenesisState(whoIsRecords []Whois) GenesisState {
-	return GenesisState{WhoisRecords: nil}
+func NewGenesisState(params Params, whoIsRecords []WhoisRecord, primaryNames []PrimaryNameRecord, reservedNames []string, offers []Offer, releases []ReleaseRecord) GenesisState {
+	return GenesisState{Params: params, WhoisRecords: whoIsRecords, PrimaryNames: primaryNames, ReservedNames: reservedNames, Offers: offers, Releases: releases}
 }
 
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
 	for _, record := range data.WhoisRecords {
-		if record.Owner == nil {
-			return fmt.Errorf("invalid WhoisRecord: Value: %s. Error: Missing Owner", record.Value)
+		if record.Name == "" {
+			return fmt.Errorf("invalid WhoisRecord: Value: %s. Error: Missing Name", record.Whois.Value)
 		}
-		if record.Value == "" {
-			return fmt.Errorf("invalid WhoisRecord: Owner: %s. Error: Missing Value", record.Owner)
+		if err := ValidateName(record.Name); err != nil {
+			return fmt.Errorf("invalid WhoisRecord: Name: %q. Error: %s", record.Name, err)
+		}
+		if record.Whois.Owner == nil {
+			return fmt.Errorf("invalid WhoisRecord: Name: %s. Error: Missing Owner", record.Name)
+		}
+		if record.Whois.Parent == "" && record.Whois.Price == nil {
+			return fmt.Errorf("invalid WhoisRecord: Name: %s. Error: Missing Price", record.Name)
+		}
+	}
+	for _, record := range data.PrimaryNames {
+		if record.Address.Empty() || record.Name == "" {
+			return fmt.Errorf("invalid PrimaryNameRecord: Address: %s, Name: %s", record.Address, record.Name)
+		}
+	}
+	for _, name := range data.ReservedNames {
+		if name == "" {
+			return fmt.Errorf("invalid reserved name: Error: Missing Name")
 		}
-		if record.Price == nil {
-			return fmt.Errorf("invalid WhoisRecord: Value: %s. Error: Missing Price", record.Value)
+		if err := ValidateName(name); err != nil {
+			return fmt.Errorf("invalid reserved name: Name: %q. Error: %s", name, err)
+		}
+	}
+	for _, offer := range data.Offers {
+		if offer.Name == "" || offer.Buyer.Empty() || ValidateName(offer.Name) != nil {
+			return fmt.Errorf("invalid Offer: Name: %q, Buyer: %s", offer.Name, offer.Buyer)
+		}
+		if !offer.Price.IsValid() || !offer.Price.IsAllPositive() {
+			return fmt.Errorf("invalid Offer: Name: %s. Error: Invalid Price %s", offer.Name, offer.Price)
+		}
+	}
+	for _, record := range data.Releases {
+		if record.Name == "" || record.Height <= 0 {
+			return fmt.Errorf("invalid ReleaseRecord: Name: %s, Height: %d", record.Name, record.Height)
 		}
 	}
 	return nil
@@ -32,26 +88,76 @@ func ValidateGenesis(data GenesisState) error {
 
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		WhoisRecords: []Whois{},
+		Params:        DefaultParams(),
+		WhoisRecords:  []WhoisRecord{},
+		PrimaryNames:  []PrimaryNameRecord{},
+		ReservedNames: []string{},
+		Offers:        []Offer{},
+		Releases:      []ReleaseRecord{},
 	}
 }
 
+// InitGenesis stores the whois records, which also rebuilds the secondary
+// indexes such as the auction end-height queue. Records without an expiry
+// start a new registration term, except subdomains which expire along with
+// their parent name.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, data.Params)
 	for _, record := range data.WhoisRecords {
-		keeper.SetWhois(ctx, record.Value, record)
+		if record.Whois.Expiry == 0 && record.Whois.Parent == "" {
+			record.Whois.Expiry = ctx.BlockHeight() + data.Params.RegistrationDuration
+		}
+		keeper.SetWhois(ctx, record.Name, record.Whois)
+	}
+	for _, record := range data.PrimaryNames {
+		if keeper.IsPrimaryNameValid(ctx, record.Address, record.Name) {
+			keeper.SetPrimaryName(ctx, record.Address, record.Name)
+		}
+	}
+	for _, name := range data.ReservedNames {
+		keeper.SetReserved(ctx, name)
+	}
+	for _, offer := range data.Offers {
+		keeper.SetOffer(ctx, offer)
+	}
+	// A released name registered again has no premium left
+	for _, record := range data.Releases {
+		if !keeper.HasOwner(ctx, record.Name) {
+			keeper.SetRelease(ctx, record.Name, record.Height)
+		}
 	}
 	return []abci.ValidatorUpdate{}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
-	var records []Whois
+	var records []WhoisRecord
 	iterator := k.GetNamesIterator(ctx)
 	for ; iterator.Valid(); iterator.Next() {
 
 		name := string(iterator.Key())
 		whois := k.GetWhois(ctx, name)
-		records = append(records, whois)
+		records = append(records, WhoisRecord{Name: name, Whois: whois})
 
 	}
-	return GenesisState{WhoisRecords: records}
+	var primaryNames []PrimaryNameRecord
+	k.IteratePrimaryNames(ctx, func(addr sdk.AccAddress, name string) bool {
+		primaryNames = append(primaryNames, PrimaryNameRecord{Address: addr, Name: name})
+		return false
+	})
+	var reservedNames []string
+	k.IterateReservedNames(ctx, func(name string) bool {
+		reservedNames = append(reservedNames, name)
+		return false
+	})
+	var offers []Offer
+	k.IterateOffers(ctx, func(offer Offer) bool {
+		offers = append(offers, offer)
+		return false
+	})
+	var releases []ReleaseRecord
+	k.IterateReleases(ctx, func(name string, height int64) bool {
+		releases = append(releases, ReleaseRecord{Name: name, Height: height})
+		return false
+	})
+	return NewGenesisState(k.GetParams(ctx), records, primaryNames, reservedNames, offers, releases)
 }
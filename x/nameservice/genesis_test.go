@@ -0,0 +1,221 @@
+package nameservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+const testDenom = "nametoken"
+
+// testInput holds a nameservice keeper along with the keepers it moves coins with
+type testInput struct {
+	ctx          sdk.Context
+	keeper       Keeper
+	bankKeeper   bank.Keeper
+	supplyKeeper supply.Keeper
+}
+
+// createTestInput sets up a nameservice keeper on an in-memory store, with
+// the module account able to burn and the default params
+func createTestInput(t *testing.T) testInput {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyNS := sdk.NewKVStoreKey(StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyNS, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "nameservice-chain", Height: 1}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName: nil,
+		ModuleName:            {supply.Burner},
+	}
+	nsAcc := supply.NewEmptyModuleAccount(ModuleName, supply.Burner)
+	blacklistedAddrs := map[string]bool{nsAcc.GetAddress().String(): true}
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), blacklistedAddrs)
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	supplyKeeper.SetModuleAccount(ctx, nsAcc)
+
+	keeper := NewKeeper(bankKeeper, supplyKeeper, cdc, keyNS, pk.Subspace(DefaultParamspace))
+	keeper.SetParams(ctx, DefaultParams())
+
+	return testInput{ctx: ctx, keeper: keeper, bankKeeper: bankKeeper, supplyKeeper: supplyKeeper}
+}
+
+// fund gives an address an amount of the test denom, counted in the total supply
+func (in testInput) fund(t *testing.T, addr sdk.AccAddress, amount int64) {
+	_, err := in.bankKeeper.AddCoins(in.ctx, addr, coins(amount))
+	require.NoError(t, err)
+	in.supplyKeeper.SetSupply(in.ctx, in.supplyKeeper.GetSupply(in.ctx).Inflate(coins(amount)))
+}
+
+// newTestAccount creates an account holding the given amount of the test denom
+func (in testInput) newTestAccount(t *testing.T, amount int64) sdk.AccAddress {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	in.fund(t, addr, amount)
+	return addr
+}
+
+// balance returns the amount of the test denom held by an account
+func (in testInput) balance(addr sdk.AccAddress) int64 {
+	return in.bankKeeper.GetCoins(in.ctx, addr).AmountOf(testDenom).Int64()
+}
+
+// supply returns the total supply of the test denom
+func (in testInput) supply() int64 {
+	return in.supplyKeeper.GetSupply(in.ctx).GetTotal().AmountOf(testDenom).Int64()
+}
+
+// registerName gives a name to an owner, off the market, expiring at a height
+func (in testInput) registerName(name string, owner sdk.AccAddress, expiry int64) {
+	whois := NewWhois(in.keeper.GetParams(in.ctx).MinNamePrice)
+	whois.Value = owner.String()
+	whois.Owner = owner
+	whois.Expiry = expiry
+	whois.SaleStatus = types.SaleStatus{SaleType: types.SaleTypeNotSale}
+	in.keeper.SetWhois(in.ctx, name, whois)
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(testDenom, amount))
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	in := createTestInput(t)
+	owner := in.newTestAccount(t, 0)
+	buyer := in.newTestAccount(t, 0)
+
+	whois := NewWhois(coins(100))
+	whois.Value = owner.String()
+	whois.Owner = owner
+	whois.Expiry = 500
+	whois.SaleStatus = types.SaleStatus{SaleType: types.SaleTypeNotSale}
+
+	offer := NewOffer("alice", buyer, coins(70), 20)
+	// The offer was escrowed by the exported chain, along with the supply
+	in.fund(t, in.supplyKeeper.GetModuleAddress(ModuleName), 70)
+
+	params := DefaultParams()
+	params.PremiumPeriod = 10
+	genesis := NewGenesisState(
+		params,
+		[]WhoisRecord{{Name: "alice", Whois: whois}},
+		[]PrimaryNameRecord{{Address: owner, Name: "alice"}},
+		[]string{"admin"},
+		[]Offer{offer},
+		[]ReleaseRecord{{Name: "bob", Height: 1}},
+	)
+	require.NoError(t, ValidateGenesis(genesis))
+	InitGenesis(in.ctx, in.keeper, genesis)
+
+	exported := ExportGenesis(in.ctx, in.keeper)
+	require.Equal(t, genesis, exported)
+	var decoded GenesisState
+	ModuleCdc.MustUnmarshalJSON(ModuleCdc.MustMarshalJSON(exported), &decoded)
+	require.Equal(t, genesis, decoded)
+
+	// The indexes are rebuilt from the imported records
+	names, _ := in.keeper.GetNamesByOwner(in.ctx, owner, "", 10)
+	require.Equal(t, []string{"alice"}, names)
+	primaryName, found := in.keeper.GetPrimaryName(in.ctx, owner)
+	require.True(t, found)
+	require.Equal(t, "alice", primaryName)
+	require.True(t, in.keeper.IsReserved(in.ctx, "admin"))
+	require.Equal(t, []Offer{offer}, in.keeper.GetOffers(in.ctx, "alice"))
+	_, premium := in.keeper.GetPriceQuote(in.ctx, "bob")
+	require.True(t, premium)
+
+	// The offer queue refunds the offer at its expiry height
+	require.Equal(t, 1, in.keeper.RefundExpiredOffers(in.ctx, offer.ExpiryHeight))
+	require.Equal(t, int64(70), in.balance(buyer))
+	require.Empty(t, in.keeper.GetOffers(in.ctx, "alice"))
+
+	// The premium queue clears the release once the premium period is over
+	in.keeper.PruneReleases(in.ctx, 1+params.PremiumPeriod)
+	_, found = in.keeper.GetReleaseHeight(in.ctx, "bob")
+	require.False(t, found)
+
+	// The expiry queue releases the name once its grace period is over
+	require.Equal(t, 1, in.keeper.ReleaseExpiredNames(in.ctx, whois.Expiry+params.GracePeriod))
+	require.False(t, in.keeper.HasOwner(in.ctx, "alice"))
+	_, found = in.keeper.GetPrimaryName(in.ctx, owner)
+	require.False(t, found)
+}
+
+// TestGenesisSkipsStaleRecords checks that a primary name no longer resolving
+// to its address and the release of a registered name are dropped on import
+func TestGenesisSkipsStaleRecords(t *testing.T) {
+	in := createTestInput(t)
+	owner := in.newTestAccount(t, 0)
+	other := in.newTestAccount(t, 0)
+
+	whois := NewWhois(coins(100))
+	whois.Value = owner.String()
+	whois.Owner = owner
+	genesis := NewGenesisState(
+		DefaultParams(),
+		[]WhoisRecord{{Name: "alice", Whois: whois}},
+		[]PrimaryNameRecord{{Address: other, Name: "alice"}},
+		[]string{},
+		[]Offer{},
+		[]ReleaseRecord{{Name: "alice", Height: 1}},
+	)
+	InitGenesis(in.ctx, in.keeper, genesis)
+
+	_, found := in.keeper.GetPrimaryName(in.ctx, other)
+	require.False(t, found)
+	_, found = in.keeper.GetReleaseHeight(in.ctx, "alice")
+	require.False(t, found)
+	require.Equal(t, in.ctx.BlockHeight()+DefaultParams().RegistrationDuration, in.keeper.GetExpiry(in.ctx, "alice"))
+}
+
+func TestValidateGenesisRejectsKeySeparator(t *testing.T) {
+	whois := NewWhois(coins(100))
+	whois.Owner = sdk.AccAddress("owner")
+	genesis := DefaultGenesisState()
+	genesis.WhoisRecords = []WhoisRecord{{Name: "alice\x00", Whois: whois}}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.ReservedNames = []string{"admin\x00"}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Offers = []Offer{NewOffer("alice\x00", sdk.AccAddress("buyer"), coins(10), 20)}
+	require.Error(t, ValidateGenesis(genesis))
+}
@@ -2,6 +2,9 @@ package nameservice
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -20,69 +23,624 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgDeleteName(ctx, keeper, msg)
 		case types.MsgSetSale:
 			return handleMsgSetSale(ctx, keeper, msg)
+		case types.MsgRenewName:
+			return handleMsgRenewName(ctx, keeper, msg)
+		case types.MsgCreateSubdomain:
+			return handleMsgCreateSubdomain(ctx, keeper, msg)
+		case types.MsgUpdateSubdomain:
+			return handleMsgUpdateSubdomain(ctx, keeper, msg)
+		case types.MsgRevokeSubdomain:
+			return handleMsgRevokeSubdomain(ctx, keeper, msg)
+		case types.MsgSetRecord:
+			return handleMsgSetRecord(ctx, keeper, msg)
+		case types.MsgClearRecord:
+			return handleMsgClearRecord(ctx, keeper, msg)
+		case types.MsgSetPrimaryName:
+			return handleMsgSetPrimaryName(ctx, keeper, msg)
+		case types.MsgRevealBid:
+			return handleMsgRevealBid(ctx, keeper, msg)
+		case types.MsgCancelSale:
+			return handleMsgCancelSale(ctx, keeper, msg)
+		case types.MsgWithdrawBid:
+			return handleMsgWithdrawBid(ctx, keeper, msg)
+		case types.MsgMakeOffer:
+			return handleMsgMakeOffer(ctx, keeper, msg)
+		case types.MsgAcceptOffer:
+			return handleMsgAcceptOffer(ctx, keeper, msg)
+		case types.MsgSwapNames:
+			return handleMsgSwapNames(ctx, keeper, msg)
+		case types.MsgBuyBundle:
+			return handleMsgBuyBundle(ctx, keeper, msg)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("Unrecognized nameservice Msg type: %v", msg.Type()))
 		}
 	}
 }
 
-// handleSetName does x
+// Handle a message to set name
 func handleMsgSetName(ctx sdk.Context, keeper Keeper, msg types.MsgSetName) (*sdk.Result, error) {
 	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) { // Checks if the the msg sender is the same as the current owner
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner") // If not, throw an error
 	}
+	if keeper.IsExpired(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Name)
+	}
+	if err := keeper.ValidateValue(ctx, msg.Value); err != nil {
+		return nil, err
+	}
 	keeper.SetName(ctx, msg.Name, msg.Value) // If so, set the name to the value specified in the msg.
-	return &sdk.Result{}, nil                // return
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeNameValueSet,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyValue, msg.Value),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
 // Handle a message to buy name
 func handleMsgBuyName(ctx sdk.Context, keeper Keeper, msg types.MsgBuyName) (*sdk.Result, error) {
+	// An expired name can only be renewed by its owner until it is released
+	if keeper.IsExpired(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Name)
+	}
+	// Subdomains are only issued by the owner of their parent name
+	if !keeper.IsNamePresent(ctx, msg.Name) && types.IsSubdomain(msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrSubdomain, msg.Name)
+	}
+	// Reserved names are only handed out by governance
+	if !keeper.HasOwner(ctx, msg.Name) && keeper.IsReserved(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameReserved, msg.Name)
+	}
+	if err := keeper.ValidateDenoms(ctx, msg.Bid.Add(msg.MaxBid...)); err != nil {
+		return nil, err
+	}
+	// A bundle is only bought whole, by a buyer who knows what it holds
+	if bundle, found := keeper.GetBundle(ctx, msg.Name); found && (bundle == msg.Name || keeper.IsBundled(ctx, msg.Name)) {
+		return nil, sdkerrors.Wrapf(types.ErrNameBundled, "%s is sold in a bundle, buy the bundle of %s instead", msg.Name, bundle)
+	}
 	saleStaus := keeper.GetSaleStaus(ctx, msg.Name)
+	// Proxy bids are raised against the later bids of an open auction
+	if !msg.MaxBid.Empty() && saleStaus.SaleType != types.SaleTypeAuction && saleStaus.SaleType != types.SaleTypeSecondPriceAuction {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "A maximum bid is only taken by an open auction")
+	}
+	// Only sealed bids carry a commitment, which they cannot do without
+	if (saleStaus.SaleType == types.SaleTypeSealedAuction) != (msg.Commitment != "") {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Commitment is only and always required by a sealed bid auction")
+	}
 	switch saleStaus.SaleType {
 	case types.SaleTypeNormal:
 		return handleNormalBuy(ctx, keeper, msg)
-	case types.SaleTypeAuction:
+	case types.SaleTypeAuction, types.SaleTypeSecondPriceAuction:
 		return handleAuctionBuy(ctx, keeper, msg)
+	case types.SaleTypeSealedAuction:
+		return handleSealedBuy(ctx, keeper, msg)
+	case types.SaleTypeDutchAuction:
+		return handleDutchBuy(ctx, keeper, msg)
 	}
 	return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is not on sale")
 }
 
 func handleNormalBuy(ctx sdk.Context, keeper Keeper, msg types.MsgBuyName) (*sdk.Result, error) {
-	// Checks if the the bid price is greater than the price paid by the current owner
-	if keeper.GetPrice(ctx, msg.Name).IsAllGT(msg.Bid) {
+	// A private sale is only open to its allowed buyers
+	saleStatus := keeper.GetSaleStaus(ctx, msg.Name)
+	if !saleStatus.IsBuyerAllowed(msg.Buyer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Name is on a private sale to other buyers")
+	}
+	// Checks if the the bid price is greater than the asking price, which includes the premium of a recently released name
+	if price, _ := keeper.GetPriceQuote(ctx, msg.Name); price.IsAllGT(msg.Bid) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Bid not high enough") // If not, throw an error
 	}
-	if keeper.HasOwner(ctx, msg.Name) {
+	registered := keeper.HasOwner(ctx, msg.Name)
+	if registered {
 		err := keeper.CoinKeeper.SendCoins(ctx, msg.Buyer, keeper.GetOwner(ctx, msg.Name), msg.Bid)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		_, err := keeper.CoinKeeper.SubtractCoins(ctx, msg.Buyer, msg.Bid) // If so, deduct the Bid amount from the sender
-		if err != nil {
+		// A new registration pays the bid out of the supply
+		if err := keeper.BurnFee(ctx, msg.Buyer, msg.Bid); err != nil {
 			return nil, err
 		}
 	}
+	seller := keeper.GetOwner(ctx, msg.Name)
 	keeper.SetOwner(ctx, msg.Name, msg.Buyer)
 	keeper.SetPrice(ctx, msg.Name, msg.Bid)
-	return &sdk.Result{}, nil
+	keeper.TransferBundle(ctx, saleStatus.Bundle, msg.Buyer)
+	// The listing of a private sale or a bundle ends with it, the name going off the market
+	if len(saleStatus.AllowedBuyers) > 0 || len(saleStatus.Bundle) > 0 {
+		if err := keeper.SetSale(ctx, msg.Name, types.SaleTypeNotSale, msg.Bid); err != nil {
+			return nil, err
+		}
+	}
+	// A new registration starts a new term, a purchase keeps the remaining one
+	if !registered {
+		keeper.SetExpiry(ctx, msg.Name, ctx.BlockHeight()+keeper.GetParams(ctx).RegistrationDuration)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeNameRegistered,
+				sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+				sdk.NewAttribute(types.AttributeKeyOwner, msg.Buyer.String()),
+				sdk.NewAttribute(types.AttributeKeyPrice, msg.Bid.String()),
+				sdk.NewAttribute(types.AttributeKeyExpiry, strconv.FormatInt(keeper.GetExpiry(ctx, msg.Name), 10)),
+				types.NewHeightAttribute(ctx.BlockHeight()),
+			),
+		)
+	} else {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeNameBought,
+				sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+				sdk.NewAttribute(types.AttributeKeySeller, seller.String()),
+				sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer.String()),
+				sdk.NewAttribute(types.AttributeKeyPrice, msg.Bid.String()),
+				types.NewHeightAttribute(ctx.BlockHeight()),
+			),
+		)
+	}
+	if len(saleStatus.Bundle) > 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBundleSold,
+				sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+				sdk.NewAttribute(types.AttributeKeySeller, seller.String()),
+				sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer.String()),
+				sdk.NewAttribute(types.AttributeKeyBundle, strings.Join(saleStatus.Bundle, ",")),
+				sdk.NewAttribute(types.AttributeKeyPrice, msg.Bid.String()),
+				types.NewHeightAttribute(ctx.BlockHeight()),
+			),
+		)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Buyer.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
 func handleAuctionBuy(ctx sdk.Context, keeper Keeper, msg types.MsgBuyName) (*sdk.Result, error) {
 	// Checks if the the bid price is greater than the price paid by the current owner
 	whois := keeper.GetWhois(ctx, msg.Name)
+	if ctx.BlockHeight() >= whois.SaleStatus.EndHeight {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Auction ended at %d", whois.SaleStatus.EndHeight)
+	}
 	if len(whois.SaleStatus.Bids) == 0 && whois.SaleStatus.Price.IsAllGT(msg.Bid) ||
 		len(whois.SaleStatus.Bids) > 0 && whois.SaleStatus.Bids[len(whois.SaleStatus.Bids)-1].Price.IsAllGTE(msg.Bid) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Bid not high enough") // If not, throw an error
 	}
+	// A known reserve price is the lowest bid, a hidden one only shows at the end
+	if !whois.SaleStatus.HideReserve && !whois.SaleStatus.MeetsReserve(msg.Bid) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "Bid below the reserve price %s", whois.SaleStatus.ReservePrice)
+	}
+	// A new bid must also raise the last one by at least the bid increment
+	if len(whois.SaleStatus.Bids) > 0 {
+		minBid := keeper.GetParams(ctx).MinNextBid(whois.SaleStatus.Bids[len(whois.SaleStatus.Bids)-1].Price)
+		if !msg.Bid.IsAllGTE(minBid) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "Bid lower than the minimum increment, at least %s", minBid)
+		}
+	}
 
 	if len(whois.SaleStatus.Bids) > 0 && whois.SaleStatus.Bids[len(whois.SaleStatus.Bids)-1].Buyer.Equals(msg.Buyer) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "The last bid is asking from youself")
 	}
 
-	keeper.AddBid(ctx, msg.Name, msg.Buyer, msg.Bid)
+	// Escrows the bid and refunds the bidder that has been outbid, raising
+	// proxy bids against each other
+	if err := keeper.AddBid(ctx, msg.Name, msg.Buyer, msg.Bid, msg.MaxBid); err != nil {
+		return nil, err
+	}
+
+	// Every bid recorded is reported at its visible price, an automatic raise of
+	// the leading proxy bid as bid_raised
+	status := keeper.GetSaleStaus(ctx, msg.Name)
+	for _, bid := range status.Bids[len(whois.SaleStatus.Bids):] {
+		eventType := types.EventTypeBidPlaced
+		if !bid.Buyer.Equals(msg.Buyer) {
+			eventType = types.EventTypeBidRaised
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, whois.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, bid.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, bid.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(status.EndHeight, 10)),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Buyer.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleSealedBuy(ctx sdk.Context, keeper Keeper, msg types.MsgBuyName) (*sdk.Result, error) {
+	whois := keeper.GetWhois(ctx, msg.Name)
+	if !whois.SaleStatus.IsCommitPhase(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPhase, "commit phase ended at %d", whois.SaleStatus.CommitEndHeight)
+	}
+	if msg.Buyer.Equals(whois.Owner) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The owner cannot bid on its own name")
+	}
+	// The deposit must cover the sale price, which is the lowest bid that can win
+	if !msg.Bid.IsAllGTE(whois.SaleStatus.Price) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Deposit not high enough")
+	}
+	if _, found := whois.SaleStatus.FindBid(msg.Buyer); found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "A sealed bid has already been committed")
+	}
+
+	// Escrows the deposit until the auction is settled
+	if err := keeper.CommitBid(ctx, msg.Name, msg.Buyer, msg.Bid, msg.Commitment); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBidCommitted,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, whois.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(whois.SaleStatus.EndHeight, 10)),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Buyer.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleDutchBuy(ctx sdk.Context, keeper Keeper, msg types.MsgBuyName) (*sdk.Result, error) {
+	whois := keeper.GetWhois(ctx, msg.Name)
+	if msg.Buyer.Equals(whois.Owner) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The owner cannot buy its own name")
+	}
+	// The first bid meeting the current price wins, paying that price and no more
+	price := keeper.GetDutchPrice(ctx, msg.Name, ctx.BlockHeight())
+	if !msg.Bid.IsAllGTE(price) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "Bid not high enough, the current price is %s", price)
+	}
+	seller := whois.Owner
+	if err := keeper.CoinKeeper.SendCoins(ctx, msg.Buyer, seller, price); err != nil {
+		return nil, err
+	}
+
+	whois.Owner = msg.Buyer
+	whois.Price = price
+	whois.SaleStatus = types.SaleStatus{
+		SaleType: types.SaleTypeNotSale,
+	}
+	keeper.SetWhois(ctx, msg.Name, whois)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeNameBought,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeySeller, seller.String()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Buyer.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to buy bundle
+func handleMsgBuyBundle(ctx sdk.Context, keeper Keeper, msg types.MsgBuyBundle) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	if keeper.IsExpired(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Name)
+	}
+	saleStatus := keeper.GetSaleStaus(ctx, msg.Name)
+	if len(saleStatus.Bundle) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is not on sale in a bundle")
+	}
+	// The buyer only pays for the bundle it asked for
+	if !saleStatus.BundleMatches(msg.Bundle) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "The bundle of %s is %s", msg.Name, strings.Join(saleStatus.Bundle, ","))
+	}
+	// The seller must still hold every name of the bundle
+	if err := keeper.ValidateBundle(ctx, msg.Name, keeper.GetOwner(ctx, msg.Name), saleStatus.Bundle); err != nil {
+		return nil, err
+	}
+	if err := keeper.ValidateDenoms(ctx, msg.Bid); err != nil {
+		return nil, err
+	}
+
+	buyMsg := types.NewMsgBuyName(msg.Name, msg.Bid, msg.Buyer)
+	switch saleStatus.SaleType {
+	case types.SaleTypeNormal:
+		return handleNormalBuy(ctx, keeper, buyMsg)
+	case types.SaleTypeAuction, types.SaleTypeSecondPriceAuction:
+		return handleAuctionBuy(ctx, keeper, buyMsg)
+	}
+	return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is not on sale")
+}
+
+// Handle a message to reveal bid
+func handleMsgRevealBid(ctx sdk.Context, keeper Keeper, msg types.MsgRevealBid) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	status := keeper.GetSaleStaus(ctx, msg.Name)
+	if status.SaleType != types.SaleTypeSealedAuction {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is not on sealed bid auction")
+	}
+	if !status.IsRevealPhase(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPhase, "reveal phase runs from %d to %d", status.CommitEndHeight+1, status.EndHeight-1)
+	}
+
+	if err := keeper.RevealBid(ctx, msg.Name, msg.Buyer, msg.Bid, msg.Salt); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBidRevealed,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Bid.String()),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Buyer.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to cancel sale
+func handleMsgCancelSale(ctx sdk.Context, keeper Keeper, msg types.MsgCancelSale) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	}
+	if keeper.GetSaleStaus(ctx, msg.Name).SaleType == types.SaleTypeNotSale {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is not on sale")
+	}
+
+	// Refunds every escrowed bid, charging the seller a penalty once bids came in
+	penalty, err := keeper.CancelSale(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSaleCancelled,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, penalty.String()),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to withdraw bid
+func handleMsgWithdrawBid(ctx sdk.Context, keeper Keeper, msg types.MsgWithdrawBid) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	switch keeper.GetSaleStaus(ctx, msg.Name).SaleType {
+	case types.SaleTypeAuction, types.SaleTypeSecondPriceAuction:
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is not on an auction with open bids")
+	}
+
+	if err := keeper.WithdrawBid(ctx, msg.Name, msg.Buyer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBidWithdrawn,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer.String()),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Buyer.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to make offer
+func handleMsgMakeOffer(ctx sdk.Context, keeper Keeper, msg types.MsgMakeOffer) (*sdk.Result, error) {
+	if !keeper.HasOwner(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	if keeper.IsExpired(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Name)
+	}
+	whois := keeper.GetWhois(ctx, msg.Name)
+	// Subdomains are only issued by the owner of their parent name
+	if whois.Parent != "" {
+		return nil, sdkerrors.Wrap(types.ErrSubdomain, msg.Name)
+	}
+	if msg.Buyer.Equals(whois.Owner) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The owner cannot make an offer on its own name")
+	}
+	// A name on sale is bought or bid on instead
+	if whois.SaleStatus.SaleType != types.SaleTypeNotSale {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is on sale")
+	}
+	if msg.ExpiryHeight <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Offer would expire at %d, before the next block", msg.ExpiryHeight)
+	}
+	if err := keeper.ValidateDenoms(ctx, msg.Price); err != nil {
+		return nil, err
+	}
+
+	// Escrows the offer until it is accepted or expires
+	if err := keeper.MakeOffer(ctx, types.NewOffer(msg.Name, msg.Buyer, msg.Price, msg.ExpiryHeight)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOfferMade,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, whois.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiry, strconv.FormatInt(msg.ExpiryHeight, 10)),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Buyer.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to accept offer
+func handleMsgAcceptOffer(ctx sdk.Context, keeper Keeper, msg types.MsgAcceptOffer) (*sdk.Result, error) {
+	if !keeper.HasOwner(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	if keeper.IsExpired(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Name)
+	}
+	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	}
+	if msg.Buyer.Equals(msg.Owner) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The owner cannot accept its own offer")
+	}
+	if keeper.IsBundled(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameBundled, msg.Name)
+	}
+	// Bidders are only let go of through a cancellation, which has its penalty
+	if len(keeper.GetSaleStaus(ctx, msg.Name).Bids) > 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The sale has bids, cancel it first")
+	}
+
+	// Pays the escrowed offer out and hands the name over in one go, unless
+	// the offer is due for a refund
+	offer, err := keeper.AcceptOffer(ctx, msg.Name, msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
 
-	return &sdk.Result{}, nil
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOfferAccepted,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeySeller, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, offer.Price.String()),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to swap names
+func handleMsgSwapNames(ctx sdk.Context, keeper Keeper, msg types.MsgSwapNames) (*sdk.Result, error) {
+	if err := checkSwappedName(ctx, keeper, msg.Name, msg.Owner); err != nil {
+		return nil, err
+	}
+	if err := checkSwappedName(ctx, keeper, msg.CounterName, msg.CounterOwner); err != nil {
+		return nil, err
+	}
+	if err := keeper.ValidateDenoms(ctx, msg.Payment); err != nil {
+		return nil, err
+	}
+
+	// Nothing is written unless both the payment and the swap go through
+	if !msg.Payment.IsZero() {
+		if err := keeper.CoinKeeper.SendCoins(ctx, msg.Owner, msg.CounterOwner, msg.Payment); err != nil {
+			return nil, err
+		}
+	}
+	keeper.SwapNames(ctx, msg.Name, msg.CounterName)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeNamesSwapped,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyCounterName, msg.CounterName),
+			sdk.NewAttribute(types.AttributeKeyCounterOwner, msg.CounterOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Payment.String()),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// checkSwappedName checks that a name can be swapped away by its owner
+func checkSwappedName(ctx sdk.Context, keeper Keeper, name string, owner sdk.AccAddress) error {
+	if !keeper.IsNamePresent(ctx, name) {
+		return sdkerrors.Wrap(types.ErrNameDoesNotExist, name)
+	}
+	if !owner.Equals(keeper.GetOwner(ctx, name)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "Incorrect Owner of %s", name)
+	}
+	if keeper.IsExpired(ctx, name) {
+		return sdkerrors.Wrap(types.ErrNameExpired, name)
+	}
+	// A subdomain stays under the control of the owner of its parent name
+	if keeper.IsSubdomain(ctx, name) {
+		return sdkerrors.Wrap(types.ErrSubdomain, name)
+	}
+	if keeper.IsBundled(ctx, name) {
+		return sdkerrors.Wrap(types.ErrNameBundled, name)
+	}
+	// Bidders are only let go of through a cancellation, which has its penalty
+	if len(keeper.GetSaleStaus(ctx, name).Bids) > 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "The sale of %s has bids, cancel it first", name)
+	}
+	return nil
 }
 
 // Handle a message to delete name
@@ -94,8 +652,25 @@ func handleMsgDeleteName(ctx sdk.Context, keeper Keeper, msg types.MsgDeleteName
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
 	}
 
+	// Returns the escrowed bid of a running auction before the name goes away
+	if err := keeper.RefundBids(ctx, msg.Name); err != nil {
+		return nil, err
+	}
 	keeper.DeleteWhois(ctx, msg.Name)
-	return &sdk.Result{}, nil
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeNameDeleted,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
 // Handle a message to set sale
@@ -106,7 +681,322 @@ func handleMsgSetSale(ctx sdk.Context, keeper Keeper, msg types.MsgSetSale) (*sd
 	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
 	}
+	if keeper.IsExpired(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Name)
+	}
+	if keeper.IsSubdomain(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrSubdomain, msg.Name)
+	}
+	// A bundled name is only sold with its bundle, until the bundle is taken off the market
+	if keeper.IsBundled(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameBundled, msg.Name)
+	}
+	if err := keeper.ValidateDenoms(ctx, msg.Price.Add(msg.FloorPrice...).Add(msg.ReservePrice...)); err != nil {
+		return nil, err
+	}
+	// Bidders are only let go of through a cancellation, which has its penalty
+	if len(keeper.GetSaleStaus(ctx, msg.Name).Bids) > 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The sale has bids, cancel it first")
+	}
+	if msg.ListingExpiry != 0 && msg.ListingExpiry <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Listing would expire at %d, before the next block", msg.ListingExpiry)
+	}
+	if err := keeper.ValidateBundle(ctx, msg.Name, msg.Owner, msg.Bundle); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if msg.SaleType == types.SaleTypeDutchAuction {
+		err = keeper.SetDutchSale(ctx, msg.Name, msg.Price, msg.FloorPrice, msg.Duration)
+	} else {
+		err = keeper.SetSale(ctx, msg.Name, msg.SaleType, msg.Price)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !msg.ReservePrice.Empty() {
+		keeper.SetReservePrice(ctx, msg.Name, msg.ReservePrice, msg.HideReserve)
+	}
+	if len(msg.AllowedBuyers) > 0 {
+		keeper.SetAllowedBuyers(ctx, msg.Name, msg.AllowedBuyers)
+	}
+	if msg.ListingExpiry != 0 {
+		keeper.SetListingExpiry(ctx, msg.Name, msg.ListingExpiry)
+	}
+	listed := sdk.NewEvent(
+		types.EventTypeSaleListed,
+		sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+		sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeySaleType, strconv.Itoa(msg.SaleType)),
+		sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+		sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(keeper.GetSaleStaus(ctx, msg.Name).EndHeight, 10)),
+		types.NewHeightAttribute(ctx.BlockHeight()),
+	)
+	if len(msg.Bundle) > 0 {
+		keeper.SetBundle(ctx, msg.Name, msg.Bundle)
+		listed = listed.AppendAttributes(sdk.NewAttribute(types.AttributeKeyBundle, strings.Join(msg.Bundle, ",")))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		listed,
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to renew name
+func handleMsgRenewName(ctx sdk.Context, keeper Keeper, msg types.MsgRenewName) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	}
+	// A subdomain lives as long as its parent name, which is the one to renew
+	if keeper.IsSubdomain(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrSubdomain, msg.Name)
+	}
+
+	// An expired name stays with its owner for the grace period, in which it can still be renewed.
+	// The renewal counts from the current height once the name has expired, or
+	// if it never had an expiry.
+	params := keeper.GetParams(ctx)
+	renewFrom := keeper.GetExpiry(ctx, msg.Name)
+	if renewFrom < ctx.BlockHeight() {
+		renewFrom = ctx.BlockHeight()
+	}
+	// Years is capped by ValidateBasic, but the blocks per year are a param
+	if msg.Years > (math.MaxInt64-renewFrom)/params.BlocksPerYear {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Renewing %s for %d years overflows its expiry", msg.Name, msg.Years)
+	}
+	if err := keeper.BurnFee(ctx, msg.Owner, params.RenewalFee(msg.Years)); err != nil { // Charges the rent for the years renewed
+		return nil, err
+	}
+	expiry := renewFrom + msg.Years*params.BlocksPerYear
+	keeper.SetExpiry(ctx, msg.Name, expiry)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeNameRenewed,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, params.RenewalFee(msg.Years).String()),
+			sdk.NewAttribute(types.AttributeKeyExpiry, strconv.FormatInt(expiry, 10)),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to create subdomain
+func handleMsgCreateSubdomain(ctx sdk.Context, keeper Keeper, msg types.MsgCreateSubdomain) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Parent) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Parent)
+	}
+	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Parent)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	}
+	if keeper.IsExpired(ctx, msg.Parent) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Parent)
+	}
+	name := types.SubdomainName(msg.Label, msg.Parent)
+	if keeper.IsNamePresent(ctx, name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExists, name)
+	}
+	if err := keeper.ValidateValue(ctx, msg.Value); err != nil {
+		return nil, err
+	}
+
+	keeper.CreateSubdomain(ctx, msg.Parent, msg.Label, msg.Value, msg.SubOwner)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubdomainCreated,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyParent, msg.Parent),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.SubOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyValue, msg.Value),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to update subdomain
+func handleMsgUpdateSubdomain(ctx sdk.Context, keeper Keeper, msg types.MsgUpdateSubdomain) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	if !keeper.IsSubdomain(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNotSubdomain, msg.Name)
+	}
+	if !msg.Owner.Equals(keeper.GetParentOwner(ctx, msg.Name)) { // Only the owner of the parent name manages its subdomains
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	}
+	if keeper.IsExpired(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Name)
+	}
+	if err := keeper.ValidateValue(ctx, msg.Value); err != nil {
+		return nil, err
+	}
+
+	keeper.SetOwner(ctx, msg.Name, msg.SubOwner)
+	keeper.SetName(ctx, msg.Name, msg.Value)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubdomainUpdated,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.SubOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyValue, msg.Value),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to revoke subdomain
+func handleMsgRevokeSubdomain(ctx sdk.Context, keeper Keeper, msg types.MsgRevokeSubdomain) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	if !keeper.IsSubdomain(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNotSubdomain, msg.Name)
+	}
+	if !msg.Owner.Equals(keeper.GetParentOwner(ctx, msg.Name)) { // Only the owner of the parent name manages its subdomains
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	}
+
+	keeper.DeleteWhois(ctx, msg.Name) // Also revokes the subdomains of the subdomain
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubdomainRevoked,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to set record
+func handleMsgSetRecord(ctx sdk.Context, keeper Keeper, msg types.MsgSetRecord) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	}
+	if keeper.IsExpired(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Name)
+	}
+	if msg.RecordType == types.RecordTypeAlias && msg.Value == msg.Name {
+		return nil, sdkerrors.Wrap(types.ErrInvalidRecord, "a name cannot be an alias of itself")
+	}
+	records := keeper.GetWhois(ctx, msg.Name).Records
+	if _, found := records.Get(msg.RecordType, msg.Key); !found && types.IsRecordKeyed(msg.RecordType) && records.KeyedLen() >= types.MaxKeyedRecords {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRecord, "a name holds at most %d address and text records", types.MaxKeyedRecords)
+	}
+
+	keeper.SetRecord(ctx, msg.Name, msg.RecordType, msg.Key, msg.Value)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecordSet,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyRecordType, msg.RecordType),
+			sdk.NewAttribute(types.AttributeKeyRecordKey, msg.Key),
+			sdk.NewAttribute(types.AttributeKeyValue, msg.Value),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to clear record
+func handleMsgClearRecord(ctx sdk.Context, keeper Keeper, msg types.MsgClearRecord) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	}
+	if keeper.IsExpired(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Name)
+	}
+	if _, found := keeper.GetWhois(ctx, msg.Name).Records.Get(msg.RecordType, msg.Key); !found {
+		return nil, sdkerrors.Wrap(types.ErrRecordNotFound, msg.Name)
+	}
+
+	keeper.ClearRecord(ctx, msg.Name, msg.RecordType, msg.Key)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecordCleared,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyRecordType, msg.RecordType),
+			sdk.NewAttribute(types.AttributeKeyRecordKey, msg.Key),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// Handle a message to set primary name
+func handleMsgSetPrimaryName(ctx sdk.Context, keeper Keeper, msg types.MsgSetPrimaryName) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	}
+	if keeper.IsExpired(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, msg.Name)
+	}
+	if !keeper.IsPrimaryNameValid(ctx, msg.Owner, msg.Name) { // The name must resolve back to the owner
+		return nil, sdkerrors.Wrap(types.ErrNotResolved, msg.Owner.String())
+	}
 
-	keeper.SetSale(ctx, msg.Name, msg.SaleType, msg.Price)
-	return &sdk.Result{}, nil
+	keeper.SetPrimaryName(ctx, msg.Owner, msg.Name)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePrimaryNameSet,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
@@ -0,0 +1,248 @@
+package nameservice
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+func TestHandleMsgRenewName(t *testing.T) {
+	cases := []struct {
+		name          string
+		expiry        int64
+		height        int64
+		years         int64
+		blocksPerYear int64
+		balance       int64
+		valid         bool
+		newExpiry     int64
+	}{
+		{"rent charged for every year renewed", 150, 50, 3, 100, 1000, true, 450},
+		{"name without an expiry renewed from the current height", 0, 50, 1, 100, 1000, true, 150},
+		{"expired name renewed from the current height", 20, 50, 1, 100, 1000, true, 150},
+		{"years over the cap rejected", 150, 50, types.MaxRenewalYears + 1, 100, 1000, false, 150},
+		{"expiry overflow rejected", 150, 50, types.MaxRenewalYears, math.MaxInt64 / 50, 1000, false, 150},
+		{"rent not affordable rejected", 150, 50, 3, 100, 25, false, 150},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			params := DefaultParams()
+			params.BlocksPerYear = tc.blocksPerYear
+			params.YearlyRent = coins(10)
+			in.keeper.SetParams(in.ctx, params)
+			owner := in.newTestAccount(t, tc.balance)
+			in.registerName("alice", owner, tc.expiry)
+			in.ctx = in.ctx.WithBlockHeight(tc.height)
+			supply := in.supply()
+
+			// The ante handler runs ValidateBasic ahead of the handler
+			msg := NewMsgRenewName("alice", tc.years, owner)
+			err := msg.ValidateBasic()
+			if err == nil {
+				_, err = NewHandler(in.keeper)(in.ctx, msg)
+			}
+
+			require.Equal(t, tc.valid, err == nil, "%v", err)
+			require.Equal(t, tc.newExpiry, in.keeper.GetExpiry(in.ctx, "alice"))
+			rent := int64(0)
+			if tc.valid {
+				rent = 10 * tc.years
+			}
+			require.Equal(t, tc.balance-rent, in.balance(owner))
+			require.Equal(t, rent, supply-in.supply())
+		})
+	}
+}
+
+// TestRenewedNameReleasedInEndBlocker checks that a renewed name is released
+// by the end blocker once the grace period after its new expiry is over
+func TestRenewedNameReleasedInEndBlocker(t *testing.T) {
+	in := createTestInput(t)
+	params := DefaultParams()
+	params.BlocksPerYear = 100
+	params.GracePeriod = 10
+	in.keeper.SetParams(in.ctx, params)
+	owner := in.newTestAccount(t, 1000)
+	in.registerName("alice", owner, 50)
+
+	_, err := NewHandler(in.keeper)(in.ctx, NewMsgRenewName("alice", 1, owner))
+	require.NoError(t, err)
+	require.Equal(t, int64(150), in.keeper.GetExpiry(in.ctx, "alice"))
+
+	// The grace period after the former expiry no longer releases the name
+	EndBlocker(in.ctx.WithBlockHeight(50+params.GracePeriod), in.keeper)
+	require.Equal(t, owner, in.keeper.GetOwner(in.ctx, "alice"))
+
+	EndBlocker(in.ctx.WithBlockHeight(150+params.GracePeriod-1), in.keeper)
+	require.Equal(t, owner, in.keeper.GetOwner(in.ctx, "alice"))
+
+	EndBlocker(in.ctx.WithBlockHeight(150+params.GracePeriod), in.keeper)
+	require.False(t, in.keeper.HasOwner(in.ctx, "alice"))
+	height, found := in.keeper.GetReleaseHeight(in.ctx, "alice")
+	require.True(t, found)
+	require.Equal(t, 150+params.GracePeriod, height)
+}
+
+func TestHandleDutchBuy(t *testing.T) {
+	cases := []struct {
+		name   string
+		height int64
+		bid    int64
+		valid  bool
+		price  int64
+	}{
+		{"bid at the start price", 1, 1000, true, 1000},
+		{"bid at the current price", 51, 550, true, 550},
+		{"bid over the current price pays the current price", 51, 800, true, 550},
+		{"bid at the floor price after the end", 150, 100, true, 100},
+		{"bid under the current price rejected", 51, 549, false, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			seller, buyer := in.newTestAccount(t, 0), in.newTestAccount(t, 1000)
+			in.registerName("alice", seller, 1000)
+			handler := NewHandler(in.keeper)
+			_, err := handler(in.ctx, NewMsgSetDutchSale(seller, "alice", coins(1000), coins(100), 100))
+			require.NoError(t, err)
+
+			in.ctx = in.ctx.WithBlockHeight(tc.height)
+			_, err = handler(in.ctx, NewMsgBuyName("alice", coins(tc.bid), buyer))
+			require.Equal(t, tc.valid, err == nil, "%v", err)
+
+			// Only the current price leaves the buyer, the rest of the bid stays with it
+			require.Equal(t, tc.price, in.balance(seller))
+			require.Equal(t, 1000-tc.price, in.balance(buyer))
+			if tc.valid {
+				require.Equal(t, buyer, in.keeper.GetOwner(in.ctx, "alice"))
+				require.Equal(t, coins(tc.price), in.keeper.GetPrice(in.ctx, "alice"))
+				require.Equal(t, types.SaleTypeNotSale, in.keeper.GetSaleStaus(in.ctx, "alice").SaleType)
+			} else {
+				require.Equal(t, seller, in.keeper.GetOwner(in.ctx, "alice"))
+				require.Equal(t, types.SaleTypeDutchAuction, in.keeper.GetSaleStaus(in.ctx, "alice").SaleType)
+			}
+		})
+	}
+}
+
+func TestHandleMsgAcceptOffer(t *testing.T) {
+	cases := []struct {
+		name   string
+		height int64
+		valid  bool
+	}{
+		{"offer accepted before its expiry height", 19, true},
+		{"offer rejected at its expiry height", 20, false},
+		{"offer rejected after its expiry height", 21, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			owner, buyer := in.newTestAccount(t, 0), in.newTestAccount(t, 1000)
+			in.registerName("alice", owner, 1000)
+			handler := NewHandler(in.keeper)
+			_, err := handler(in.ctx, NewMsgMakeOffer("alice", coins(100), 20, buyer))
+			require.NoError(t, err)
+
+			in.ctx = in.ctx.WithBlockHeight(tc.height)
+			_, err = handler(in.ctx, NewMsgAcceptOffer("alice", buyer, owner))
+			require.Equal(t, tc.valid, err == nil, "%v", err)
+			if tc.valid {
+				require.Equal(t, buyer, in.keeper.GetOwner(in.ctx, "alice"))
+				require.Equal(t, int64(100), in.balance(owner))
+				return
+			}
+			require.True(t, types.ErrOfferExpired.Is(err), "%v", err)
+			require.Equal(t, owner, in.keeper.GetOwner(in.ctx, "alice"))
+
+			// The end blocker refunds the offer the owner could not accept
+			EndBlocker(in.ctx, in.keeper)
+			require.Equal(t, int64(1000), in.balance(buyer))
+			require.Empty(t, in.keeper.GetOffers(in.ctx, "alice"))
+		})
+	}
+}
+
+func TestHandleMsgSwapNames(t *testing.T) {
+	cases := []struct {
+		name string
+		// setup changes the names before the swap, returning its message
+		setup func(t *testing.T, in testInput, alice, bob, carol sdk.AccAddress) MsgSwapNames
+		valid bool
+	}{
+		{
+			name: "swap paid by the owner",
+			setup: func(t *testing.T, in testInput, alice, bob, carol sdk.AccAddress) MsgSwapNames {
+				return NewMsgSwapNames("foo", alice, "bar", bob, coins(50))
+			},
+			valid: true,
+		},
+		{
+			name: "payment not affordable",
+			setup: func(t *testing.T, in testInput, alice, bob, carol sdk.AccAddress) MsgSwapNames {
+				return NewMsgSwapNames("foo", alice, "bar", bob, coins(500))
+			},
+		},
+		{
+			name: "counter name of another owner",
+			setup: func(t *testing.T, in testInput, alice, bob, carol sdk.AccAddress) MsgSwapNames {
+				return NewMsgSwapNames("foo", alice, "bar", carol, coins(50))
+			},
+		},
+		{
+			name: "counter name with bids",
+			setup: func(t *testing.T, in testInput, alice, bob, carol sdk.AccAddress) MsgSwapNames {
+				require.NoError(t, in.keeper.SetSale(in.ctx, "bar", types.SaleTypeAuction, coins(10)))
+				require.NoError(t, in.keeper.AddBid(in.ctx, "bar", carol, coins(20), nil))
+				return NewMsgSwapNames("foo", alice, "bar", bob, coins(50))
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			alice, bob, carol := in.newTestAccount(t, 100), in.newTestAccount(t, 100), in.newTestAccount(t, 100)
+			in.registerName("foo", alice, 1000)
+			in.registerName("bar", bob, 1000)
+			in.keeper.SetPrimaryName(in.ctx, alice, "foo")
+			in.keeper.SetPrimaryName(in.ctx, bob, "bar")
+			msg := tc.setup(t, in, alice, bob, carol)
+			balances := []int64{in.balance(alice), in.balance(bob), in.balance(carol)}
+
+			// The handler runs without the cache of a transaction, so that any
+			// write made before failing would show
+			_, err := NewHandler(in.keeper)(in.ctx, msg)
+			require.Equal(t, tc.valid, err == nil, "%v", err)
+
+			owners := map[string]sdk.AccAddress{"foo": alice, "bar": bob}
+			primaryNames := 2
+			if tc.valid {
+				owners = map[string]sdk.AccAddress{"foo": bob, "bar": alice}
+				balances[0], balances[1] = balances[0]-50, balances[1]+50
+				primaryNames = 0
+			}
+			for name, owner := range owners {
+				require.Equal(t, owner, in.keeper.GetOwner(in.ctx, name))
+				names, _ := in.keeper.GetNamesByOwner(in.ctx, owner, "", 10)
+				require.Equal(t, []string{name}, names)
+			}
+			require.Equal(t, balances, []int64{in.balance(alice), in.balance(bob), in.balance(carol)})
+			found := 0
+			in.keeper.IteratePrimaryNames(in.ctx, func(addr sdk.AccAddress, name string) bool {
+				require.Equal(t, owners[name], addr)
+				found++
+				return false
+			})
+			require.Equal(t, primaryNames, found)
+		})
+	}
+}
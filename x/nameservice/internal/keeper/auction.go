@@ -0,0 +1,347 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// AddBid - escrows the bid in the module account, refunds the previous highest
+// bidder and records the new bid as the leading one. Outbid bids stay in the
+// list, which keeps the runner-up price of a second price auction, and keep
+// the DefaultPenalty share of their escrow as a deposit until the auction
+// ends, which is forfeited should they fail to pay as a fallback. A bid in
+// the last ExtensionWindow blocks of the auction pushes its end out by
+// ExtensionBlocks, up to MaxExtensions times.
+//
+// A proxy bid escrows its maximum price and is raised by the bid increment
+// whenever it is outbid, up to that maximum. Between two proxy bids, the one
+// with the lower maximum is recorded at it, and the other one leads by the
+// increment over it, the earlier one winning a tie.
+func (k Keeper) AddBid(ctx sdk.Context, name string, buyer sdk.AccAddress, price, maxPrice sdk.Coins) error {
+	whois := k.GetWhois(ctx, name)
+	bid := types.Bid{
+		Buyer:       buyer,
+		Price:       price,
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockHeader().Time.Unix(),
+		MaxPrice:    maxPrice,
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, bid.Escrow()); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	bids := whois.SaleStatus.Bids
+	if len(bids) > 0 && bids[len(bids)-1].Escrow().IsAllGTE(bid.Escrow()) {
+		// The leading proxy bid is raised over the new bid, which goes up to its
+		// maximum in vain and is refunded right away but for its deposit
+		bid.Price, bid.MaxPrice = bid.Escrow(), nil
+		if err := k.keepDeposit(ctx, &bid, params); err != nil {
+			return err
+		}
+		leading := bids[len(bids)-1]
+		bids[len(bids)-1].MaxPrice = nil
+		leading.Price = minCoins(params.MinNextBid(bid.Price), leading.Escrow())
+		leading.BlockHeight, leading.Timestamp = bid.BlockHeight, bid.Timestamp
+		bids = append(bids, bid, leading)
+	} else {
+		if len(bids) > 0 {
+			// An outbid proxy bid goes up to its maximum, which the new bid
+			// leads by the increment
+			leading := bids[len(bids)-1]
+			if !leading.MaxPrice.Empty() {
+				bids[len(bids)-1].MaxPrice = nil
+				leading.Price, leading.MaxPrice = leading.MaxPrice, nil
+				leading.BlockHeight, leading.Timestamp = bid.BlockHeight, bid.Timestamp
+				bids = append(bids, leading)
+			}
+			if err := k.keepDeposit(ctx, &bids[len(bids)-1], params); err != nil {
+				return err
+			}
+			bid.Price = maxCoins(bid.Price, minCoins(params.MinNextBid(leading.Price), bid.Escrow()))
+		}
+		bids = append(bids, bid)
+	}
+	whois.SaleStatus.Bids = bids
+
+	if whois.SaleStatus.EndHeight-ctx.BlockHeight() <= params.ExtensionWindow &&
+		whois.SaleStatus.Extensions < params.MaxExtensions {
+		whois.SaleStatus.EndHeight += params.ExtensionBlocks
+		whois.SaleStatus.Extensions++
+	}
+	k.SetWhois(ctx, name, whois)
+	return nil
+}
+
+// RefundBids - returns the escrowed coins of a sale to its bidders, which
+// are the leading bid of an auction along with the deposits of the outbid
+// ones, or every deposit of a sealed bid auction, and clears the bids of the
+// sale
+func (k Keeper) RefundBids(ctx sdk.Context, name string) error {
+	whois := k.GetWhois(ctx, name)
+	if len(whois.SaleStatus.Bids) == 0 {
+		return nil
+	}
+	if whois.SaleStatus.SaleType == types.SaleTypeSealedAuction {
+		if err := k.refundDeposits(ctx, whois.SaleStatus); err != nil {
+			return err
+		}
+	} else {
+		if err := k.refundLeadingBid(ctx, whois.SaleStatus); err != nil {
+			return err
+		}
+		if err := k.refundDeposits(ctx, whois.SaleStatus); err != nil {
+			return err
+		}
+		whois.SaleStatus.EndHeight = 0
+	}
+
+	whois.SaleStatus.Bids = nil
+	k.SetWhois(ctx, name, whois)
+	return nil
+}
+
+// CancelSale - takes a name off the market, refunding every escrowed bid. A
+// sale with bids costs its seller the cancel penalty on the highest bid,
+// which is burned. It returns the penalty paid.
+func (k Keeper) CancelSale(ctx sdk.Context, name string) (sdk.Coins, error) {
+	whois := k.GetWhois(ctx, name)
+	penalty := sdk.NewCoins()
+	if len(whois.SaleStatus.Bids) > 0 {
+		penalty = k.GetParams(ctx).CancelPenaltyOf(whois.SaleStatus.HighestBid())
+	}
+	if err := k.BurnFee(ctx, whois.Owner, penalty); err != nil {
+		return nil, err
+	}
+	if err := k.RefundBids(ctx, name); err != nil {
+		return nil, err
+	}
+
+	whois = k.GetWhois(ctx, name)
+	whois.SaleStatus = types.SaleStatus{
+		SaleType: types.SaleTypeNotSale,
+	}
+	k.SetWhois(ctx, name, whois)
+	return penalty, nil
+}
+
+// WithdrawBid - withdraws the bids of an outbid buyer from an auction, so that
+// the buyer is no longer asked to pay should the leading bid fail to settle.
+// Outbid bids have already been refunded but for their deposit, which is
+// returned. They stay in the list, keeping the runner-up price of a second
+// price auction.
+func (k Keeper) WithdrawBid(ctx sdk.Context, name string, buyer sdk.AccAddress) error {
+	whois := k.GetWhois(ctx, name)
+	bids := whois.SaleStatus.Bids
+	if len(bids) > 0 && bids[len(bids)-1].Buyer.Equals(buyer) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The leading bid cannot be withdrawn")
+	}
+
+	withdrawn := false
+	for i := range bids {
+		if bids[i].Buyer.Equals(buyer) && !bids[i].Withdrawn {
+			if !bids[i].Deposit.IsZero() {
+				if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, bids[i].Deposit); err != nil {
+					return err
+				}
+			}
+			bids[i].Withdrawn, bids[i].Deposit = true, nil
+			withdrawn = true
+		}
+	}
+	if !withdrawn {
+		return sdkerrors.Wrap(types.ErrBidNotFound, buyer.String())
+	}
+	k.SetWhois(ctx, name, whois)
+	return nil
+}
+
+// keepDeposit refunds an outbid open bid, which was fully escrowed while it
+// led, but for the DefaultPenalty share of its price kept as its deposit
+func (k Keeper) keepDeposit(ctx sdk.Context, bid *types.Bid, params types.Params) error {
+	bid.Deposit = params.DefaultPenaltyOf(bid.Price)
+	if refund := bid.Price.Sub(bid.Deposit); !refund.IsZero() {
+		return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Buyer, refund)
+	}
+	return nil
+}
+
+// refundLeadingBid returns the escrowed coins of the highest bid, if any.
+// Every other bid holds no more than its deposit in escrow.
+func (k Keeper) refundLeadingBid(ctx sdk.Context, status types.SaleStatus) error {
+	if len(status.Bids) == 0 {
+		return nil
+	}
+	lastBid := status.Bids[len(status.Bids)-1]
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lastBid.Buyer, lastBid.Escrow())
+}
+
+// minCoins returns the lower of two amounts
+func minCoins(a, b sdk.Coins) sdk.Coins {
+	if a.IsAllGTE(b) {
+		return b
+	}
+	return a
+}
+
+// maxCoins returns the higher of two amounts
+func maxCoins(a, b sdk.Coins) sdk.Coins {
+	if a.IsAllGTE(b) {
+		return a
+	}
+	return b
+}
+
+// isScheduled returns whether the settlement of a sale is in the auction
+// queue, which is the case of any auction from its listing
+func isScheduled(status types.SaleStatus) bool {
+	switch status.SaleType {
+	case types.SaleTypeAuction, types.SaleTypeSecondPriceAuction, types.SaleTypeSealedAuction:
+		return status.EndHeight > 0
+	}
+	return false
+}
+
+// insertAuctionQueue schedules the settlement of an auction at its end height
+func (k Keeper) insertAuctionQueue(ctx sdk.Context, name string, status types.SaleStatus) {
+	if !isScheduled(status) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AuctionQueueKey(status.EndHeight, name), []byte{})
+}
+
+// removeFromAuctionQueue unschedules the settlement of an auction, if any
+func (k Keeper) removeFromAuctionQueue(ctx sdk.Context, name string, status types.SaleStatus) {
+	if !isScheduled(status) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AuctionQueueKey(status.EndHeight, name))
+}
+
+// AuctionQueueIterator returns an iterator over all auctions ending at or
+// before the given height
+func (k Keeper) AuctionQueueIterator(ctx sdk.Context, endHeight int64) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.AuctionQueueKeyPrefix, sdk.PrefixEndBytes(types.AuctionQueueHeightKey(endHeight)))
+}
+
+// FinishAuctions settles every auction due at the current height. Only the
+// auctions in the end-height queue are visited, so the cost does not depend
+// on the size of the registry. Every due auction leaves the queue, sold or
+// not, and a failure to settle is reported by an event rather than retried.
+func (k Keeper) FinishAuctions(ctx sdk.Context, curBlockHeight int64) int {
+	finished := 0
+
+	var names []string
+	it := k.AuctionQueueIterator(ctx, curBlockHeight)
+	for ; it.Valid(); it.Next() {
+		_, name := types.SplitAuctionQueueKey(it.Key())
+		names = append(names, name)
+	}
+	it.Close()
+
+	for _, name := range names {
+		whois := k.GetWhois(ctx, name)
+		status := whois.SaleStatus
+		switch {
+		case k.IsExpired(ctx, name):
+			// The seller no longer holds a name past its expiry, which is
+			// released at the end of the grace period
+			k.finishRefunded(ctx, name, "name expired", curBlockHeight)
+		case status.SaleType == types.SaleTypeSealedAuction:
+			if k.finishSealedAuction(ctx, name, whois, curBlockHeight) {
+				finished++
+			}
+		case len(status.Bids) == 0:
+			// An auction nobody bid on ends with the name off the market
+			k.endUnsold(ctx, name, "no bids", curBlockHeight)
+		case !status.MeetsReserve(status.Bids[len(status.Bids)-1].Price):
+			k.finishRefunded(ctx, name, "reserve price not met", curBlockHeight)
+		default:
+			// A bundle whose seller let go of one of its names is no longer sold
+			if err := k.ValidateBundle(ctx, name, whois.Owner, status.Bundle); err != nil {
+				k.finishRefunded(ctx, name, "bundle broken: "+err.Error(), curBlockHeight)
+				continue
+			}
+			if k.finishOpenAuction(ctx, name, whois, curBlockHeight) {
+				finished++
+			}
+		}
+	}
+
+	return finished
+}
+
+// finishRefunded ends an auction whose bids cannot be settled, because the
+// highest one fell short of the reserve price, the name expired or the bundle
+// on sale broke up.
+// The name stays with the seller, the bids are refunded and the reserve price
+// is no longer hidden.
+func (k Keeper) finishRefunded(ctx sdk.Context, name, reason string, height int64) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.RefundBids(cacheCtx, name); err != nil {
+		k.settlementFailed(ctx, name, k.GetWhois(ctx, name), types.Bid{}, err, height)
+	} else {
+		write()
+	}
+	k.endUnsold(ctx, name, reason, height)
+}
+
+// endUnsold takes a name whose auction ended unsold off the market, keeping
+// its reserve price on record
+func (k Keeper) endUnsold(ctx sdk.Context, name, reason string, height int64) {
+	whois := k.GetWhois(ctx, name)
+	whois.SaleStatus = types.SaleStatus{
+		SaleType:     types.SaleTypeNotSale,
+		ReservePrice: whois.SaleStatus.ReservePrice,
+	}
+	k.SetWhois(ctx, name, whois)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionUnsold,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeySeller, whois.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, whois.SaleStatus.ReservePrice.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			types.NewHeightAttribute(height),
+		),
+	)
+}
+
+// finishOneAuction pays the seller the settlement price out of the escrowed
+// winning bid, refunds the rest of the bid to the winner and hands the name
+// over to it, along with the other names of a bundle. A second price auction
+// keeps its clearing price on record.
+func (k Keeper) finishOneAuction(ctx sdk.Context, name string, bid types.Bid, price sdk.Coins) error {
+	whois := k.GetWhois(ctx, name)
+	if !whois.Owner.Empty() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, whois.Owner, price)
+		if err != nil {
+			return err
+		}
+	}
+	if change := bid.Escrow().Sub(price); !change.IsZero() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Buyer, change)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.TransferBundle(ctx, whois.SaleStatus.Bundle, bid.Buyer)
+	saleType := whois.SaleStatus.SaleType
+	whois.Owner = bid.Buyer
+	whois.Price = price
+	whois.SaleStatus = types.SaleStatus{
+		SaleType:     types.SaleTypeNotSale,
+		ReservePrice: whois.SaleStatus.ReservePrice,
+	}
+	if saleType == types.SaleTypeSecondPriceAuction {
+		whois.SaleStatus.ClearingPrice = price
+	}
+	k.SetWhois(ctx, name, whois)
+
+	return nil
+}
@@ -0,0 +1,369 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// bidStep places a bid, a proxy bid when it has a maximum price, and gives
+// the state expected after it. Accounts are numbered from the seller on.
+type bidStep struct {
+	bidder          int
+	price, maxPrice int64
+
+	balances     []int64
+	escrow       int64
+	leader       int
+	leadingPrice int64
+}
+
+func TestAddBid(t *testing.T) {
+	const seller, alice, bob, carol = 0, 1, 2, 3
+	// Losing its escrow makes the leading bid fail to settle
+	loseEscrow := func(t *testing.T, in testInput, escrow int64) {
+		require.NoError(t, in.supplyKeeper.BurnCoins(in.ctx, types.ModuleName, coins(escrow)))
+	}
+	outbidProxy := []bidStep{
+		{bidder: alice, price: 100, maxPrice: 300, balances: []int64{0, 700, 1000, 1000}, escrow: 300, leader: alice, leadingPrice: 100},
+		// alice is refunded the escrow but for the deposit of a bid raised to its maximum
+		{bidder: bob, price: 100, maxPrice: 500, balances: []int64{0, 970, 500, 1000}, escrow: 530, leader: bob, leadingPrice: 330},
+	}
+
+	cases := []struct {
+		name         string
+		bids         []bidStep
+		beforeSettle func(t *testing.T, in testInput, accs []sdk.AccAddress)
+		owner        int
+		balances     []int64
+		burned       int64
+	}{
+		{
+			name: "losing proxy bid is refunded right away but for its deposit",
+			bids: []bidStep{
+				{bidder: alice, price: 100, maxPrice: 300, balances: []int64{0, 700, 1000, 1000}, escrow: 300, leader: alice, leadingPrice: 100},
+				{bidder: bob, price: 150, maxPrice: 250, balances: []int64{0, 700, 975, 1000}, escrow: 325, leader: alice, leadingPrice: 275},
+			},
+			owner:    alice,
+			balances: []int64{275, 725, 1000, 1000},
+		},
+		{
+			name: "outbid proxy bid is refunded but for its deposit",
+			bids: append(outbidProxy,
+				bidStep{bidder: carol, price: 400, balances: []int64{0, 970, 500, 960}, escrow: 570, leader: bob, leadingPrice: 440},
+			),
+			owner:    bob,
+			balances: []int64{440, 1000, 560, 1000},
+		},
+		{
+			name: "tie goes to the earlier bid",
+			bids: []bidStep{
+				{bidder: alice, price: 100, maxPrice: 300, balances: []int64{0, 700, 1000, 1000}, escrow: 300, leader: alice, leadingPrice: 100},
+				{bidder: bob, price: 300, balances: []int64{0, 700, 970, 1000}, escrow: 330, leader: alice, leadingPrice: 300},
+				{bidder: carol, price: 200, maxPrice: 300, balances: []int64{0, 700, 970, 970}, escrow: 360, leader: alice, leadingPrice: 300},
+			},
+			owner:    alice,
+			balances: []int64{300, 700, 1000, 1000},
+		},
+		{
+			name: "escrow difference is refunded at settlement",
+			bids: []bidStep{
+				{bidder: alice, price: 50, maxPrice: 400, balances: []int64{0, 600, 1000, 1000}, escrow: 400, leader: alice, leadingPrice: 50},
+			},
+			owner:    alice,
+			balances: []int64{50, 950, 1000, 1000},
+		},
+		{
+			name: "fallback pays the rest of an outbid proxy bid over its deposit",
+			bids: outbidProxy,
+			beforeSettle: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				loseEscrow(t, in, 500)
+			},
+			owner:    alice,
+			balances: []int64{300, 700, 500, 1000},
+		},
+		{
+			name: "defaulting fallback bidder forfeits its deposit to the next one",
+			bids: []bidStep{
+				{bidder: alice, price: 100, balances: []int64{0, 900, 1000, 1000}, escrow: 100, leader: alice, leadingPrice: 100},
+				{bidder: bob, price: 200, balances: []int64{0, 990, 800, 1000}, escrow: 210, leader: bob, leadingPrice: 200},
+				{bidder: carol, price: 300, maxPrice: 500, balances: []int64{0, 990, 980, 500}, escrow: 530, leader: carol, leadingPrice: 300},
+			},
+			beforeSettle: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				loseEscrow(t, in, 500)
+				require.NoError(t, in.bankKeeper.SendCoins(in.ctx, accs[bob], accs[carol], coins(980)))
+			},
+			owner:    alice,
+			balances: []int64{100, 900, 0, 1480},
+			burned:   20,
+		},
+		{
+			name: "no fallback bidder able to pay leaves the name unsold",
+			bids: outbidProxy,
+			beforeSettle: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				loseEscrow(t, in, 500)
+				require.NoError(t, in.bankKeeper.SendCoins(in.ctx, accs[alice], accs[carol], coins(970)))
+			},
+			owner:    seller,
+			balances: []int64{0, 0, 500, 1970},
+			burned:   30,
+		},
+		{
+			name: "bids below the reserve price are refunded",
+			bids: []bidStep{
+				{bidder: alice, price: 100, balances: []int64{0, 900, 1000, 1000}, escrow: 100, leader: alice, leadingPrice: 100},
+				{bidder: bob, price: 200, balances: []int64{0, 990, 800, 1000}, escrow: 210, leader: bob, leadingPrice: 200},
+			},
+			beforeSettle: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				in.keeper.SetReservePrice(in.ctx, "foo", coins(250), true)
+			},
+			owner:    seller,
+			balances: []int64{0, 1000, 1000, 1000},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			params := in.keeper.GetParams(in.ctx)
+			params.BidIncrement = sdk.NewDecWithPrec(1, 1)
+			in.keeper.SetParams(in.ctx, params)
+
+			accs := []sdk.AccAddress{in.newTestAccount(t, 0)}
+			for i := 0; i < 3; i++ {
+				accs = append(accs, in.newTestAccount(t, 1000))
+			}
+			in.registerName("foo", accs[seller])
+			require.NoError(t, in.keeper.SetSale(in.ctx, "foo", types.SaleTypeAuction, coins(10)))
+
+			for i, step := range tc.bids {
+				var maxPrice sdk.Coins
+				if step.maxPrice > 0 {
+					maxPrice = coins(step.maxPrice)
+				}
+				require.NoError(t, in.keeper.AddBid(in.ctx, "foo", accs[step.bidder], coins(step.price), maxPrice), "bid %d", i)
+				require.Equal(t, step.balances, in.balances(accs), "balances after bid %d", i)
+				require.Equal(t, step.escrow, in.escrow(), "escrow after bid %d", i)
+				bids := in.keeper.GetSaleStaus(in.ctx, "foo").Bids
+				require.Equal(t, accs[step.leader], bids[len(bids)-1].Buyer, "leader after bid %d", i)
+				require.Equal(t, coins(step.leadingPrice), bids[len(bids)-1].Price, "leading price after bid %d", i)
+			}
+
+			if tc.beforeSettle != nil {
+				tc.beforeSettle(t, in, accs)
+			}
+			supply := in.supply()
+			in.settle("foo")
+			require.Equal(t, accs[tc.owner], in.keeper.GetOwner(in.ctx, "foo"))
+			require.Equal(t, tc.balances, in.balances(accs))
+			require.Equal(t, int64(0), in.escrow())
+			require.Equal(t, tc.burned, supply-in.supply())
+			require.Empty(t, in.keeper.GetSaleStaus(in.ctx, "foo").Bids)
+		})
+	}
+}
+
+func TestCancelSale(t *testing.T) {
+	const seller, alice, bob = 0, 1, 2
+	cases := []struct {
+		name          string
+		sellerBalance int64
+		bids          func(t *testing.T, in testInput, accs []sdk.AccAddress)
+		valid         bool
+		balances      []int64
+		escrow        int64
+	}{
+		{
+			name:          "sale without bids cancelled for free",
+			sellerBalance: 100,
+			valid:         true,
+			balances:      []int64{100, 1000, 1000},
+		},
+		{
+			name:          "seller burns the penalty on the highest bid and bidders are refunded",
+			sellerBalance: 100,
+			bids: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				require.NoError(t, in.keeper.AddBid(in.ctx, "foo", accs[alice], coins(100), nil))
+				require.NoError(t, in.keeper.AddBid(in.ctx, "foo", accs[bob], coins(200), nil))
+			},
+			valid:    true,
+			balances: []int64{90, 1000, 1000},
+		},
+		{
+			name:          "proxy bid refunded up to its maximum price",
+			sellerBalance: 100,
+			bids: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				require.NoError(t, in.keeper.AddBid(in.ctx, "foo", accs[alice], coins(200), coins(600)))
+			},
+			valid:    true,
+			balances: []int64{90, 1000, 1000},
+		},
+		{
+			name:          "seller unable to pay the penalty keeps the sale",
+			sellerBalance: 5,
+			bids: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				require.NoError(t, in.keeper.AddBid(in.ctx, "foo", accs[alice], coins(100), nil))
+				require.NoError(t, in.keeper.AddBid(in.ctx, "foo", accs[bob], coins(200), nil))
+			},
+			balances: []int64{5, 990, 800},
+			escrow:   210,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			accs := []sdk.AccAddress{in.newTestAccount(t, tc.sellerBalance), in.newTestAccount(t, 1000), in.newTestAccount(t, 1000)}
+			in.registerName("foo", accs[seller])
+			require.NoError(t, in.keeper.SetSale(in.ctx, "foo", types.SaleTypeAuction, coins(10)))
+			if tc.bids != nil {
+				tc.bids(t, in, accs)
+			}
+
+			supply := in.supply()
+			penalty, err := in.keeper.CancelSale(in.ctx, "foo")
+			require.Equal(t, tc.valid, err == nil, "%v", err)
+			require.Equal(t, tc.balances, in.balances(accs))
+			require.Equal(t, tc.escrow, in.escrow())
+			require.Equal(t, accs[seller], in.keeper.GetOwner(in.ctx, "foo"))
+			if tc.valid {
+				require.Equal(t, tc.sellerBalance-tc.balances[seller], penalty.AmountOf(testDenom).Int64())
+				require.Equal(t, tc.sellerBalance-tc.balances[seller], supply-in.supply())
+				require.Equal(t, types.SaleStatus{SaleType: types.SaleTypeNotSale}, in.keeper.GetSaleStaus(in.ctx, "foo"))
+			} else {
+				require.Equal(t, supply, in.supply())
+				require.Equal(t, types.SaleTypeAuction, in.keeper.GetSaleStaus(in.ctx, "foo").SaleType)
+			}
+		})
+	}
+}
+
+func TestWithdrawBid(t *testing.T) {
+	const seller, alice, bob, carol = 0, 1, 2, 3
+	cases := []struct {
+		name     string
+		withdraw []int
+		// err is the error of the last withdrawal, the earlier ones succeeding
+		err        *sdkerrors.Error
+		candidates []int
+		balances   []int64
+		escrow     int64
+	}{
+		{
+			name:       "outbid bidder withdrawn from the fallback",
+			withdraw:   []int{bob},
+			candidates: []int{carol, alice},
+			balances:   []int64{0, 990, 1000, 700},
+			escrow:     310,
+		},
+		{
+			name:       "every outbid bidder withdrawn",
+			withdraw:   []int{alice, bob},
+			candidates: []int{carol},
+			balances:   []int64{0, 1000, 1000, 700},
+			escrow:     300,
+		},
+		{
+			name:       "leading bid cannot be withdrawn",
+			withdraw:   []int{carol},
+			err:        sdkerrors.ErrInvalidRequest,
+			candidates: []int{carol, bob, alice},
+			balances:   []int64{0, 990, 980, 700},
+			escrow:     330,
+		},
+		{
+			name:       "buyer without a bid",
+			withdraw:   []int{seller},
+			err:        types.ErrBidNotFound,
+			candidates: []int{carol, bob, alice},
+			balances:   []int64{0, 990, 980, 700},
+			escrow:     330,
+		},
+		{
+			name:       "bid withdrawn only once",
+			withdraw:   []int{bob, bob},
+			err:        types.ErrBidNotFound,
+			candidates: []int{carol, alice},
+			balances:   []int64{0, 990, 1000, 700},
+			escrow:     310,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			accs := []sdk.AccAddress{in.newTestAccount(t, 0)}
+			for i := 0; i < 3; i++ {
+				accs = append(accs, in.newTestAccount(t, 1000))
+			}
+			in.registerName("foo", accs[seller])
+			require.NoError(t, in.keeper.SetSale(in.ctx, "foo", types.SaleTypeAuction, coins(10)))
+			for i, bidder := range []int{alice, bob, carol} {
+				require.NoError(t, in.keeper.AddBid(in.ctx, "foo", accs[bidder], coins(int64(100*(i+1))), nil))
+			}
+
+			for i, bidder := range tc.withdraw {
+				err := in.keeper.WithdrawBid(in.ctx, "foo", accs[bidder])
+				if i < len(tc.withdraw)-1 || tc.err == nil {
+					require.NoError(t, err)
+				} else {
+					require.True(t, tc.err.Is(err), "expected %v, got %v", tc.err, err)
+				}
+			}
+
+			status := in.keeper.GetSaleStaus(in.ctx, "foo")
+			var candidates []int
+			for _, i := range status.SettlementCandidates() {
+				for bidder, acc := range accs {
+					if status.Bids[i].Buyer.Equals(acc) {
+						candidates = append(candidates, bidder)
+					}
+				}
+			}
+			require.Equal(t, tc.candidates, candidates)
+			require.Equal(t, tc.balances, in.balances(accs))
+			require.Equal(t, tc.escrow, in.escrow())
+
+			// The deposits left are refunded once the leading bid settles
+			in.settle("foo")
+			require.Equal(t, accs[carol], in.keeper.GetOwner(in.ctx, "foo"))
+			require.Equal(t, []int64{300, 1000, 1000, 700}, in.balances(accs))
+			require.Equal(t, int64(0), in.escrow())
+		})
+	}
+}
+
+// BenchmarkFinishAuctions settles the same number of due auctions in
+// registries of growing size, which should not change the cost per block
+func BenchmarkFinishAuctions(b *testing.B) {
+	const dueAuctions = 10
+	for _, registrySize := range []int{1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("registry=%d", registrySize), func(b *testing.B) {
+			in := createTestInput(b)
+			owner, bidder := in.newTestAccount(b, 1000), in.newTestAccount(b, 1000000)
+			for i := 0; i < registrySize; i++ {
+				in.registerName(testName(i), owner)
+			}
+			for i := 0; i < dueAuctions; i++ {
+				name := testName(i)
+				require.NoError(b, in.keeper.SetSale(in.ctx, name, types.SaleTypeAuction, coins(10)))
+				require.NoError(b, in.keeper.AddBid(in.ctx, name, bidder, coins(20), nil))
+			}
+			endHeight := in.keeper.GetSaleStaus(in.ctx, testName(0)).EndHeight
+
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				// Every run settles the same auctions, its writes being discarded
+				ctx, _ := in.ctx.WithBlockHeight(endHeight).CacheContext()
+				if finished := in.keeper.FinishAuctions(ctx, endHeight); finished != dueAuctions {
+					b.Fatalf("settled %d auctions, expected %d", finished, dueAuctions)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// insertBundle indexes the other names of the bundle of a sale under the name
+// leading it
+func (k Keeper) insertBundle(ctx sdk.Context, name string, status types.SaleStatus) {
+	store := ctx.KVStore(k.storeKey)
+	for _, member := range status.Bundle {
+		store.Set(types.BundleKey(member), []byte(name))
+	}
+}
+
+// removeBundle removes the index entries of the other names of the bundle of
+// a sale, if any
+func (k Keeper) removeBundle(ctx sdk.Context, name string, status types.SaleStatus) {
+	store := ctx.KVStore(k.storeKey)
+	for _, member := range status.Bundle {
+		if string(store.Get(types.BundleKey(member))) == name {
+			store.Delete(types.BundleKey(member))
+		}
+	}
+}
+
+// GetBundle - gets the name leading the bundle sale a name is part of, which
+// is the name itself for the leading name
+func (k Keeper) GetBundle(ctx sdk.Context, name string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.BundleKey(name)); bz != nil {
+		return string(bz), true
+	}
+	if len(k.GetSaleStaus(ctx, name).Bundle) > 0 {
+		return name, true
+	}
+	return "", false
+}
+
+// IsBundled - returns whether a name is sold in the bundle of another name of
+// its owner, and so cannot be sold on its own
+func (k Keeper) IsBundled(ctx sdk.Context, name string) bool {
+	bundle, found := k.GetBundle(ctx, name)
+	return found && bundle != name && k.GetOwner(ctx, bundle).Equals(k.GetOwner(ctx, name))
+}
+
+// SetBundle - sells other names along with a name on sale as one bundle
+func (k Keeper) SetBundle(ctx sdk.Context, name string, bundle []string) {
+	whois := k.GetWhois(ctx, name)
+	whois.SaleStatus.Bundle = bundle
+	k.SetWhois(ctx, name, whois)
+}
+
+// ValidateBundle - checks that the other names of a bundle can be sold along
+// with a name by its owner: every one of them is owned by the seller, has not
+// expired and is neither on an auction of its own nor in another bundle. It is
+// checked when the bundle is listed and again when it is sold.
+func (k Keeper) ValidateBundle(ctx sdk.Context, name string, owner sdk.AccAddress, bundle []string) error {
+	for _, member := range bundle {
+		if !k.IsNamePresent(ctx, member) {
+			return sdkerrors.Wrap(types.ErrNameDoesNotExist, member)
+		}
+		whois := k.GetWhois(ctx, member)
+		if !owner.Equals(whois.Owner) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "Incorrect Owner of %s", member)
+		}
+		if whois.IsExpired(ctx.BlockHeight()) {
+			return sdkerrors.Wrap(types.ErrNameExpired, member)
+		}
+		// A subdomain stays under the control of the owner of its parent name
+		if whois.Parent != "" {
+			return sdkerrors.Wrap(types.ErrSubdomain, member)
+		}
+		// A name left on a normal sale is not sold on its own while bundled
+		switch whois.SaleStatus.SaleType {
+		case types.SaleTypeNotSale, types.SaleTypeNormal:
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is on an auction of its own", member)
+		}
+		if other, found := k.GetBundle(ctx, member); found && other != name {
+			return sdkerrors.Wrapf(types.ErrNameBundled, "%s is in the bundle of %s", member, other)
+		}
+	}
+	return nil
+}
+
+// TransferBundle - hands the other names of a sold bundle over to its buyer,
+// off the market like the name leading the bundle. A bundled name is never on
+// an auction, so there are no bids to refund, only the listing of the seller
+// to drop.
+func (k Keeper) TransferBundle(ctx sdk.Context, bundle []string, buyer sdk.AccAddress) {
+	for _, member := range bundle {
+		whois := k.GetWhois(ctx, member)
+		whois.Owner = buyer
+		whois.SaleStatus = types.SaleStatus{SaleType: types.SaleTypeNotSale}
+		k.SetWhois(ctx, member, whois)
+	}
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+func TestFinishBundleAuction(t *testing.T) {
+	cases := []struct {
+		name string
+		bid  int64
+		sold bool
+		// memberSales are the sale types of the bundled names once settled
+		memberSales []types.SaleType
+	}{
+		{"sold bundle hands every name over off the market", 100, true, []types.SaleType{types.SaleTypeNotSale, types.SaleTypeNotSale}},
+		{"unsold bundle leaves the names with the seller", 0, false, []types.SaleType{types.SaleTypeNotSale, types.SaleTypeNormal}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			seller, buyer := in.newTestAccount(t, 0), in.newTestAccount(t, 1000)
+			for _, name := range []string{"foo", "bar", "baz"} {
+				in.registerName(name, seller)
+			}
+			// A bundled name may be left on a normal sale, which it is not sold on
+			require.NoError(t, in.keeper.SetSale(in.ctx, "baz", types.SaleTypeNormal, coins(10)))
+			bundle := []string{"bar", "baz"}
+			require.NoError(t, in.keeper.ValidateBundle(in.ctx, "foo", seller, bundle))
+			require.NoError(t, in.keeper.SetSale(in.ctx, "foo", types.SaleTypeAuction, coins(10)))
+			in.keeper.SetBundle(in.ctx, "foo", bundle)
+			for _, member := range bundle {
+				require.True(t, in.keeper.IsBundled(in.ctx, member))
+			}
+			if tc.bid > 0 {
+				require.NoError(t, in.keeper.AddBid(in.ctx, "foo", buyer, coins(tc.bid), nil))
+			}
+
+			in.settle("foo")
+			owner := seller
+			if tc.sold {
+				owner = buyer
+			}
+			names, _ := in.keeper.GetNamesByOwner(in.ctx, owner, "", 10)
+			require.Equal(t, []string{"bar", "baz", "foo"}, names)
+			require.Equal(t, types.SaleTypeNotSale, in.keeper.GetSaleStaus(in.ctx, "foo").SaleType)
+			for i, member := range bundle {
+				require.Equal(t, owner, in.keeper.GetOwner(in.ctx, member))
+				require.Equal(t, tc.memberSales[i], in.keeper.GetSaleStaus(in.ctx, member).SaleType, member)
+				_, found := in.keeper.GetBundle(in.ctx, member)
+				require.False(t, found, member)
+			}
+			require.Equal(t, []int64{1000 - tc.bid, tc.bid}, in.balances([]sdk.AccAddress{buyer, seller}))
+			require.Equal(t, int64(0), in.escrow())
+		})
+	}
+}
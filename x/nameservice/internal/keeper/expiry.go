@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// IsExpired - returns whether the registration of a name is over. A
+// subdomain expires along with its parent name.
+func (k Keeper) IsExpired(ctx sdk.Context, name string) bool {
+	whois := k.GetWhois(ctx, name)
+	if whois.Parent != "" {
+		return k.IsExpired(ctx, whois.Parent)
+	}
+	return whois.IsExpired(ctx.BlockHeight())
+}
+
+// GetExpiry - gets the height at which a name expires, which for a subdomain
+// is the expiry of its parent name
+func (k Keeper) GetExpiry(ctx sdk.Context, name string) int64 {
+	whois := k.GetWhois(ctx, name)
+	if whois.Parent != "" {
+		return k.GetExpiry(ctx, whois.Parent)
+	}
+	return whois.Expiry
+}
+
+// SetExpiry - sets the height at which a name expires
+func (k Keeper) SetExpiry(ctx sdk.Context, name string, expiry int64) {
+	whois := k.GetWhois(ctx, name)
+	whois.Expiry = expiry
+	k.SetWhois(ctx, name, whois)
+}
+
+// insertExpiryQueue schedules the release of a name at its expiry
+func (k Keeper) insertExpiryQueue(ctx sdk.Context, name string, expiry int64) {
+	if expiry == 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ExpiryQueueKey(expiry, name), []byte{})
+}
+
+// removeFromExpiryQueue unschedules the release of a name
+func (k Keeper) removeFromExpiryQueue(ctx sdk.Context, name string, expiry int64) {
+	if expiry == 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ExpiryQueueKey(expiry, name))
+}
+
+// ExpiryQueueIterator returns an iterator over all names expiring at or
+// before the given height
+func (k Keeper) ExpiryQueueIterator(ctx sdk.Context, height int64) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.ExpiryQueueKeyPrefix, sdk.PrefixEndBytes(types.ExpiryQueueHeightKey(height)))
+}
+
+// ReleaseExpiredNames releases every name whose grace period is over at the
+// given height back to the open market, refunding the bids of running
+// auctions. Released names are sold at a premium for a while.
+func (k Keeper) ReleaseExpiredNames(ctx sdk.Context, height int64) int {
+	released := 0
+	maxExpiry := height - k.GetParams(ctx).GracePeriod
+	if maxExpiry <= 0 {
+		return released
+	}
+
+	var names []string
+	it := k.ExpiryQueueIterator(ctx, maxExpiry)
+	for ; it.Valid(); it.Next() {
+		_, name := types.SplitExpiryQueueKey(it.Key())
+		names = append(names, name)
+	}
+	it.Close()
+
+	for _, name := range names {
+		if err := k.RefundBids(ctx, name); err != nil {
+			k.Logger(ctx).Error("failed to refund bids of expired name", "name", name, "err", err)
+			continue
+		}
+		owner := k.GetOwner(ctx, name)
+		k.DeleteWhois(ctx, name)
+		k.SetRelease(ctx, name, height)
+		released++
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeNameReleased,
+				sdk.NewAttribute(types.AttributeKeyName, name),
+				sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+				types.NewHeightAttribute(height),
+			),
+		)
+	}
+
+	return released
+}
+
+// GetReleaseHeight - gets the height at which a name was released, if it is
+// still within its premium period
+func (k Keeper) GetReleaseHeight(ctx sdk.Context, name string) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReleaseKey(name))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// SetRelease - records the height at which a name was released, which starts
+// its premium period
+func (k Keeper) SetRelease(ctx sdk.Context, name string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReleaseKey(name), sdk.Uint64ToBigEndian(uint64(height)))
+	store.Set(types.PremiumQueueKey(height, name), []byte{})
+}
+
+// removeRelease clears the premium of a released name once it is registered again
+func (k Keeper) removeRelease(ctx sdk.Context, name string) {
+	releaseHeight, found := k.GetReleaseHeight(ctx, name)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ReleaseKey(name))
+	store.Delete(types.PremiumQueueKey(releaseHeight, name))
+}
+
+// IterateReleases - iterates over the names still within their premium period
+// along with their release height, stopping when the callback returns true
+func (k Keeper) IterateReleases(ctx sdk.Context, cb func(name string, height int64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ReleaseKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if cb(string(it.Key()[len(types.ReleaseKeyPrefix):]), int64(binary.BigEndian.Uint64(it.Value()))) {
+			return
+		}
+	}
+}
+
+// PruneReleases clears the release records whose premium period is over at
+// the given height
+func (k Keeper) PruneReleases(ctx sdk.Context, height int64) {
+	maxReleaseHeight := height - k.GetParams(ctx).PremiumPeriod
+	if maxReleaseHeight <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var names []string
+	it := store.Iterator(types.PremiumQueueKeyPrefix, sdk.PrefixEndBytes(types.PremiumQueueHeightKey(maxReleaseHeight)))
+	for ; it.Valid(); it.Next() {
+		_, name := types.SplitPremiumQueueKey(it.Key())
+		names = append(names, name)
+	}
+	it.Close()
+
+	for _, name := range names {
+		k.removeRelease(ctx, name)
+	}
+}
+
+// GetPriceQuote - gets the price a buyer currently pays for a name. A name
+// released within the premium period costs a premium decaying linearly
+// toward the minimum name price, as does a name on a dutch auction toward
+// its floor price.
+func (k Keeper) GetPriceQuote(ctx sdk.Context, name string) (price sdk.Coins, premium bool) {
+	if k.HasOwner(ctx, name) {
+		if k.GetSaleStaus(ctx, name).SaleType == types.SaleTypeDutchAuction {
+			return k.GetDutchPrice(ctx, name, ctx.BlockHeight()), false
+		}
+		return k.GetPrice(ctx, name), false
+	}
+
+	params := k.GetParams(ctx)
+	releaseHeight, found := k.GetReleaseHeight(ctx, name)
+	if !found {
+		return params.MinNamePrice, false
+	}
+	elapsed := ctx.BlockHeight() - releaseHeight
+	if elapsed >= params.PremiumPeriod {
+		return params.MinNamePrice, false
+	}
+	return types.DecayPrice(params.PremiumStartPrice, params.MinNamePrice, elapsed, params.PremiumPeriod), true
+}
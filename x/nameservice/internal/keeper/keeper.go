@@ -2,11 +2,11 @@ package keeper
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
@@ -14,19 +14,26 @@ import (
 
 // Keeper of the nameservice store
 type Keeper struct {
-	CoinKeeper types.BankKeeper
-	storeKey   sdk.StoreKey
-	cdc        *codec.Codec
-	paramspace types.ParamSubspace
+	CoinKeeper   types.BankKeeper
+	supplyKeeper types.SupplyKeeper
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramspace   types.ParamSubspace
 }
 
 // NewKeeper creates a nameservice keeper
-func NewKeeper(coinKeeper bank.Keeper, cdc *codec.Codec, key sdk.StoreKey, paramspace types.ParamSubspace) Keeper {
+func NewKeeper(coinKeeper bank.Keeper, supplyKeeper types.SupplyKeeper, cdc *codec.Codec, key sdk.StoreKey, paramspace types.ParamSubspace) Keeper {
+	// ensure the escrow module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
 	keeper := Keeper{
-		CoinKeeper: coinKeeper,
-		storeKey:   key,
-		cdc:        cdc,
-		paramspace: paramspace.WithKeyTable(types.ParamKeyTable()),
+		CoinKeeper:   coinKeeper,
+		supplyKeeper: supplyKeeper,
+		storeKey:     key,
+		cdc:          cdc,
+		paramspace:   paramspace.WithKeyTable(types.ParamKeyTable()),
 	}
 	return keeper
 }
@@ -36,46 +43,91 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func (k Keeper) set(ctx sdk.Context, key string, value interface{}) {
+func (k Keeper) set(ctx sdk.Context, key []byte, value interface{}) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(value)
-	store.Set([]byte(key), bz)
+	store.Set(key, bz)
 }
 
-func (k Keeper) delete(ctx sdk.Context, key string) {
+func (k Keeper) delete(ctx sdk.Context, key []byte) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete([]byte(key))
+	store.Delete(key)
 }
 
+// SetWhois - stores the whois of a name and keeps the secondary indexes in sync
 func (k Keeper) SetWhois(ctx sdk.Context, name string, whois types.Whois) {
 	if whois.Owner.Empty() {
 		return
 	}
-	k.set(ctx, name, whois)
+	var prevOwner sdk.AccAddress
+	if k.IsNamePresent(ctx, name) {
+		prev := k.GetWhois(ctx, name)
+		prevOwner = prev.Owner
+		k.removeIndexes(ctx, name, prev)
+	}
+	k.set(ctx, types.WhoisKey(name), whois)
+	k.setIndexes(ctx, name, whois)
+	if prevOwner != nil {
+		k.invalidatePrimaryName(ctx, name, prevOwner)
+	}
 }
 
 func (k Keeper) GetWhois(ctx sdk.Context, name string) types.Whois {
 	store := ctx.KVStore(k.storeKey)
 	if !k.IsNamePresent(ctx, name) {
-		return types.NewWhois()
+		return types.NewWhois(k.GetParams(ctx).MinNamePrice)
 	}
-	bz := store.Get([]byte(name))
+	bz := store.Get(types.WhoisKey(name))
 	var whois types.Whois
 
 	err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &whois)
 	if err != nil {
-		return types.NewWhois()
+		return types.NewWhois(k.GetParams(ctx).MinNamePrice)
 	}
 
 	return whois
 }
 
+// DeleteWhois - removes the whois of a name along with its index entries.
+// Deleting a name also deletes all of its subdomains.
 func (k Keeper) DeleteWhois(ctx sdk.Context, name string) {
-	k.delete(ctx, name)
+	if !k.IsNamePresent(ctx, name) {
+		return
+	}
+	k.deleteSubdomains(ctx, name)
+	whois := k.GetWhois(ctx, name)
+	k.removeIndexes(ctx, name, whois)
+	k.delete(ctx, types.WhoisKey(name))
+	k.invalidatePrimaryName(ctx, name, whois.Owner)
+}
+
+// setIndexes adds the secondary index entries derived from a whois
+func (k Keeper) setIndexes(ctx sdk.Context, name string, whois types.Whois) {
+	k.insertOwner(ctx, name, whois.Owner)
+	k.insertAuctionQueue(ctx, name, whois.SaleStatus)
+	k.insertListingQueue(ctx, name, whois.SaleStatus)
+	k.insertBundle(ctx, name, whois.SaleStatus)
+	k.insertExpiryQueue(ctx, name, whois.Expiry)
+	k.insertSubdomain(ctx, name, whois)
+	k.removeRelease(ctx, name)
 }
 
-// ResolveName - returns the string that the name resolves to
+// removeIndexes removes the secondary index entries derived from a whois
+func (k Keeper) removeIndexes(ctx sdk.Context, name string, whois types.Whois) {
+	k.removeOwner(ctx, name, whois.Owner)
+	k.removeFromAuctionQueue(ctx, name, whois.SaleStatus)
+	k.removeFromListingQueue(ctx, name, whois.SaleStatus)
+	k.removeBundle(ctx, name, whois.SaleStatus)
+	k.removeFromExpiryQueue(ctx, name, whois.Expiry)
+	k.removeSubdomain(ctx, name, whois)
+}
+
+// ResolveName - returns the string that the name resolves to, or an empty
+// string once the name has expired
 func (k Keeper) ResolveName(ctx sdk.Context, name string) string {
+	if k.IsExpired(ctx, name) {
+		return ""
+	}
 	return k.GetWhois(ctx, name).Value
 }
 
@@ -86,6 +138,29 @@ func (k Keeper) SetName(ctx sdk.Context, name string, value string) {
 	k.SetWhois(ctx, name, whois)
 }
 
+// ResolveRecord - returns the value of a typed record of a name, unless the
+// name has expired
+func (k Keeper) ResolveRecord(ctx sdk.Context, name, recordType, key string) (string, bool) {
+	if k.IsExpired(ctx, name) {
+		return "", false
+	}
+	return k.GetWhois(ctx, name).Records.Get(recordType, key)
+}
+
+// SetRecord - sets a typed record of a name
+func (k Keeper) SetRecord(ctx sdk.Context, name, recordType, key, value string) {
+	whois := k.GetWhois(ctx, name)
+	whois.Records = whois.Records.Set(recordType, key, value)
+	k.SetWhois(ctx, name, whois)
+}
+
+// ClearRecord - removes a typed record of a name
+func (k Keeper) ClearRecord(ctx sdk.Context, name, recordType, key string) {
+	whois := k.GetWhois(ctx, name)
+	whois.Records = whois.Records.Clear(recordType, key)
+	k.SetWhois(ctx, name, whois)
+}
+
 // HasOwner - returns whether or not the name already has an owner
 func (k Keeper) HasOwner(ctx sdk.Context, name string) bool {
 	return !k.GetWhois(ctx, name).Owner.Empty()
@@ -114,17 +189,6 @@ func (k Keeper) GetSaleStaus(ctx sdk.Context, name string) types.SaleStatus {
 	return whois.SaleStatus
 }
 
-func (k Keeper) AddBid(ctx sdk.Context, name string, buyer sdk.AccAddress, price sdk.Coins) {
-	whois := k.GetWhois(ctx, name)
-	whois.SaleStatus.Bids = append(whois.SaleStatus.Bids, types.Bid{
-		Buyer:       buyer,
-		Price:       price,
-		BlockHeight: ctx.BlockHeight(),
-		Timestamp:   time.Now().Unix(),
-	})
-	k.SetWhois(ctx, name, whois)
-}
-
 // SetPrice - sets the current price of a name
 func (k Keeper) SetPrice(ctx sdk.Context, name string, price sdk.Coins) {
 	whois := k.GetWhois(ctx, name)
@@ -135,71 +199,164 @@ func (k Keeper) SetPrice(ctx sdk.Context, name string, price sdk.Coins) {
 // Check if the name is present in the store or not
 func (k Keeper) IsNamePresent(ctx sdk.Context, name string) bool {
 	store := ctx.KVStore(k.storeKey)
-	return store.Has([]byte(name))
+	return store.Has(types.WhoisKey(name))
 }
 
 // Get an iterator over all names in which the keys are the names and the values are the whois
 func (k Keeper) GetNamesIterator(ctx sdk.Context) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisKeyPrefix)
 	return sdk.KVStorePrefixIterator(store, []byte{})
 }
 
-// SetSale - sets the current price of a name
-func (k Keeper) SetSale(ctx sdk.Context, name string, saleType types.SaleType, price sdk.Coins) {
-	whois := k.GetWhois(ctx, name)
-	whois.Price = price
-	whois.SaleStatus = types.SaleStatus{
-		SaleType: saleType,
-		Price:    price,
+// GetNamesPage - gets a page of the names matching the params along with the
+// cursor of the next page, if any. Names are sorted in ascending order unless
+// params.Reverse is set, the cursor being the last name of the page in both cases.
+// A page stops after MaxScannedNames names so that a sparse sale type filter
+// does not read through the whole registry, its cursor then being the last
+// name read. Such a page may hold fewer names than the limit, or none at all.
+func (k Keeper) GetNamesPage(ctx sdk.Context, params types.QueryNamesParams, limit int) (names []string, next string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhoisKeyPrefix)
+
+	start, end := []byte(params.Prefix), sdk.PrefixEndBytes([]byte(params.Prefix))
+	if params.Reverse {
+		if params.StartAfter != "" && (end == nil || params.StartAfter < string(end)) {
+			end = []byte(params.StartAfter)
+		}
+	} else if after := append([]byte(params.StartAfter), 0x00); params.StartAfter != "" && string(after) > string(start) {
+		start = after
+	}
+	if end != nil && string(start) >= string(end) {
+		return nil, ""
 	}
-	k.SetWhois(ctx, name, whois)
-}
-
-func (k Keeper) FinishAuctions(ctx sdk.Context, curBlockHeight int64) int {
-	finished := 0
 
-	for it := k.GetNamesIterator(ctx); it.Valid(); it.Next() {
-		name := string(it.Key())
-		whois := k.GetWhois(ctx, name)
+	var it sdk.Iterator
+	if params.Reverse {
+		it = store.ReverseIterator(start, end)
+	} else {
+		it = store.Iterator(start, end)
+	}
+	defer it.Close()
 
-		if whois.SaleStatus.SaleType != types.SaleTypeAuction ||
-			len(whois.SaleStatus.Bids) == 0 {
-			continue
+	var last string
+	for scanned := 0; it.Valid(); it.Next() {
+		if scanned == types.MaxScannedNames {
+			return names, last
 		}
-
-		lastBid := whois.SaleStatus.Bids[len(whois.SaleStatus.Bids)-1]
-		if curBlockHeight-lastBid.BlockHeight > types.AuctionFinishBlockInterval {
-			if err := k.finishOneAuction(ctx, name, lastBid.Buyer, lastBid.Price); err != nil {
-
-			} else {
-				finished++
+		scanned++
+		last = string(it.Key())
+
+		if len(params.SaleTypes) > 0 {
+			var whois types.Whois
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &whois)
+			// A bundled name is on the sale of its bundle
+			saleType := whois.SaleStatus.SaleType
+			if bundle, found := k.GetBundle(ctx, last); found {
+				saleType = k.GetSaleStaus(ctx, bundle).SaleType
 			}
+			if !params.MatchSaleType(saleType) {
+				continue
+			}
+		}
+		if len(names) == limit {
+			return names, names[len(names)-1]
 		}
+		names = append(names, last)
 	}
-
-	return finished
+	return names, ""
 }
 
-func (k Keeper) finishOneAuction(ctx sdk.Context, name string, buyer sdk.AccAddress, price sdk.Coins) error {
-	_, err := k.CoinKeeper.SubtractCoins(ctx, buyer, price) // If so, deduct the Bid amount from the sender
-	if err != nil {
+// SetSale - sets the current price of a name, refunding any bid escrowed for
+// the previous sale. An auction takes bids for AuctionInterval blocks and a
+// sealed bid auction starts its commit phase right away, both are scheduled
+// for settlement at their end height.
+func (k Keeper) SetSale(ctx sdk.Context, name string, saleType types.SaleType, price sdk.Coins) error {
+	if err := k.RefundBids(ctx, name); err != nil {
 		return err
 	}
 
 	whois := k.GetWhois(ctx, name)
-	whois.Owner = buyer
 	whois.Price = price
 	whois.SaleStatus = types.SaleStatus{
-		SaleType: types.SaleTypeNotSale,
+		SaleType: saleType,
+		Price:    price,
+	}
+	params := k.GetParams(ctx)
+	switch saleType {
+	case types.SaleTypeAuction, types.SaleTypeSecondPriceAuction:
+		whois.SaleStatus.EndHeight = ctx.BlockHeight() + params.AuctionInterval + 1
+	case types.SaleTypeSealedAuction:
+		whois.SaleStatus.CommitEndHeight = ctx.BlockHeight() + params.CommitPeriod
+		whois.SaleStatus.EndHeight = whois.SaleStatus.CommitEndHeight + params.RevealPeriod + 1
 	}
 	k.SetWhois(ctx, name, whois)
+	return nil
+}
 
-	if k.HasOwner(ctx, name) {
-		err := k.CoinKeeper.SendCoins(ctx, buyer, k.GetOwner(ctx, name), price)
-		if err != nil {
-			return err
-		}
+// SetDutchSale - lists a name on a dutch auction, its price falling from the
+// start price at the current height down to the floor price after duration
+// blocks
+func (k Keeper) SetDutchSale(ctx sdk.Context, name string, startPrice, floorPrice sdk.Coins, duration int64) error {
+	if err := k.SetSale(ctx, name, types.SaleTypeDutchAuction, startPrice); err != nil {
+		return err
 	}
 
+	whois := k.GetWhois(ctx, name)
+	whois.SaleStatus.FloorPrice = floorPrice
+	whois.SaleStatus.StartHeight = ctx.BlockHeight()
+	whois.SaleStatus.EndHeight = ctx.BlockHeight() + duration
+	k.SetWhois(ctx, name, whois)
 	return nil
 }
+
+// SetReservePrice - sets the lowest price an auction sells at, hidden from
+// queries until the auction ends if asked to
+func (k Keeper) SetReservePrice(ctx sdk.Context, name string, reservePrice sdk.Coins, hidden bool) {
+	whois := k.GetWhois(ctx, name)
+	whois.SaleStatus.ReservePrice = reservePrice
+	whois.SaleStatus.HideReserve = hidden
+	k.SetWhois(ctx, name, whois)
+}
+
+// SwapNames - exchanges the owners of two names, taking both off the market.
+// What the names resolve to goes along with them.
+func (k Keeper) SwapNames(ctx sdk.Context, name, counterName string) {
+	whois, counterWhois := k.GetWhois(ctx, name), k.GetWhois(ctx, counterName)
+	whois.Owner, counterWhois.Owner = counterWhois.Owner, whois.Owner
+	whois.SaleStatus = types.SaleStatus{SaleType: types.SaleTypeNotSale}
+	counterWhois.SaleStatus = types.SaleStatus{SaleType: types.SaleTypeNotSale}
+	k.SetWhois(ctx, name, whois)
+	k.SetWhois(ctx, counterName, counterWhois)
+}
+
+// SetAllowedBuyers - restricts the sale of a name to the given buyers, making
+// it a private sale
+func (k Keeper) SetAllowedBuyers(ctx sdk.Context, name string, buyers []sdk.AccAddress) {
+	whois := k.GetWhois(ctx, name)
+	whois.SaleStatus.AllowedBuyers = buyers
+	k.SetWhois(ctx, name, whois)
+}
+
+// SetListingExpiry - sets the last height at which a name is listed on a
+// normal sale
+func (k Keeper) SetListingExpiry(ctx sdk.Context, name string, listingExpiry int64) {
+	whois := k.GetWhois(ctx, name)
+	whois.SaleStatus.ListingExpiry = listingExpiry
+	k.SetWhois(ctx, name, whois)
+}
+
+// GetDutchPrice - gets the price of a name on a dutch auction at the given height
+func (k Keeper) GetDutchPrice(ctx sdk.Context, name string, height int64) sdk.Coins {
+	return k.GetSaleStaus(ctx, name).DutchPriceAt(height)
+}
+
+// BurnFee charges a fee to an account through the module account, which burns
+// it so that the total supply goes down along with the balance
+func (k Keeper) BurnFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, fee); err != nil {
+		return err
+	}
+	return k.supplyKeeper.BurnCoins(ctx, types.ModuleName, fee)
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+const testDenom = "nametoken"
+
+// testInput holds a nameservice keeper along with the keepers it moves coins with
+type testInput struct {
+	ctx          sdk.Context
+	keeper       Keeper
+	bankKeeper   bank.Keeper
+	supplyKeeper supply.Keeper
+}
+
+func makeTestCodec() *codec.Codec {
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	return cdc
+}
+
+// createTestInput sets up a nameservice keeper on an in-memory store, with
+// the module account able to burn and the default params
+func createTestInput(t testing.TB) testInput {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyNS := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyNS, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "nameservice-chain", Height: 1}, false, log.NewNopLogger())
+	cdc := makeTestCodec()
+
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName: nil,
+		types.ModuleName:      {supply.Burner},
+	}
+	nsAcc := supply.NewEmptyModuleAccount(types.ModuleName, supply.Burner)
+	blacklistedAddrs := map[string]bool{nsAcc.GetAddress().String(): true}
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), blacklistedAddrs)
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	supplyKeeper.SetModuleAccount(ctx, nsAcc)
+
+	keeper := NewKeeper(bankKeeper, supplyKeeper, cdc, keyNS, pk.Subspace(types.DefaultParamspace))
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	return testInput{ctx: ctx, keeper: keeper, bankKeeper: bankKeeper, supplyKeeper: supplyKeeper}
+}
+
+// newTestAccount creates an account holding the given amount of the test
+// denom, counted in the total supply
+func (in testInput) newTestAccount(t testing.TB, amount int64) sdk.AccAddress {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom, amount))
+	_, err := in.bankKeeper.AddCoins(in.ctx, addr, coins)
+	require.NoError(t, err)
+	in.supplyKeeper.SetSupply(in.ctx, in.supplyKeeper.GetSupply(in.ctx).Inflate(coins))
+	return addr
+}
+
+// balance returns the amount of the test denom held by an account
+func (in testInput) balance(addr sdk.AccAddress) int64 {
+	return in.bankKeeper.GetCoins(in.ctx, addr).AmountOf(testDenom).Int64()
+}
+
+// balances returns the amounts of the test denom held by accounts
+func (in testInput) balances(addrs []sdk.AccAddress) []int64 {
+	amounts := make([]int64, len(addrs))
+	for i, addr := range addrs {
+		amounts[i] = in.balance(addr)
+	}
+	return amounts
+}
+
+// escrow returns the amount of the test denom held by the module account
+func (in testInput) escrow() int64 {
+	return in.supplyKeeper.GetModuleAccount(in.ctx, types.ModuleName).GetCoins().AmountOf(testDenom).Int64()
+}
+
+// supply returns the total supply of the test denom
+func (in testInput) supply() int64 {
+	return in.supplyKeeper.GetSupply(in.ctx).GetTotal().AmountOf(testDenom).Int64()
+}
+
+// settle runs the settlement of an auction at its end height
+func (in testInput) settle(name string) {
+	endHeight := in.keeper.GetSaleStaus(in.ctx, name).EndHeight
+	in.keeper.FinishAuctions(in.ctx.WithBlockHeight(endHeight), endHeight)
+}
+
+// registerName gives a name to an owner, off the market
+func (in testInput) registerName(name string, owner sdk.AccAddress) {
+	whois := types.NewWhois(in.keeper.GetParams(in.ctx).MinNamePrice)
+	whois.Owner = owner
+	whois.Expiry = in.ctx.BlockHeight() + in.keeper.GetParams(in.ctx).RegistrationDuration
+	whois.SaleStatus = types.SaleStatus{SaleType: types.SaleTypeNotSale}
+	in.keeper.SetWhois(in.ctx, name, whois)
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(testDenom, amount))
+}
+
+func testName(i int) string {
+	return fmt.Sprintf("name%07d", i)
+}
+
+func TestBurnFee(t *testing.T) {
+	in := createTestInput(t)
+	payer := in.newTestAccount(t, 100)
+
+	require.NoError(t, in.keeper.BurnFee(in.ctx, payer, coins(30)))
+	require.Equal(t, int64(70), in.balance(payer))
+	require.Equal(t, int64(0), in.escrow())
+	require.Equal(t, int64(70), in.supply())
+
+	require.Error(t, in.keeper.BurnFee(in.ctx, payer, coins(71)))
+	require.NoError(t, in.keeper.BurnFee(in.ctx, payer, nil))
+}
+
+func TestGetNamesPageScanLimit(t *testing.T) {
+	in := createTestInput(t)
+	owner := in.newTestAccount(t, 1000)
+	for i := 0; i < types.MaxScannedNames+5; i++ {
+		in.registerName(testName(i), owner)
+	}
+	onSale := testName(types.MaxScannedNames + 2)
+	require.NoError(t, in.keeper.SetSale(in.ctx, onSale, types.SaleTypeNormal, coins(10)))
+
+	// The first page reads up to the scan limit without finding a name on sale
+	params := types.NewQueryNamesParams("", 10, "", []types.SaleType{types.SaleTypeNormal}, false)
+	names, next := in.keeper.GetNamesPage(in.ctx, params, 10)
+	require.Empty(t, names)
+	require.Equal(t, testName(types.MaxScannedNames-1), next)
+
+	params.StartAfter = next
+	names, next = in.keeper.GetNamesPage(in.ctx, params, 10)
+	require.Equal(t, []string{onSale}, names)
+	require.Empty(t, next)
+}
+
+func TestSwapNames(t *testing.T) {
+	in := createTestInput(t)
+	alice, bob, carol := in.newTestAccount(t, 0), in.newTestAccount(t, 0), in.newTestAccount(t, 0)
+	for _, name := range []struct {
+		name  string
+		owner sdk.AccAddress
+	}{{"foo", alice}, {"bar", bob}, {"baz", carol}} {
+		in.registerName(name.name, name.owner)
+		in.keeper.SetName(in.ctx, name.name, name.owner.String())
+		in.keeper.SetPrimaryName(in.ctx, name.owner, name.name)
+	}
+	require.NoError(t, in.keeper.SetSale(in.ctx, "foo", types.SaleTypeNormal, coins(10)))
+
+	in.keeper.SwapNames(in.ctx, "foo", "bar")
+
+	// The names change owners along with the owner index, keeping their values
+	require.Equal(t, bob, in.keeper.GetOwner(in.ctx, "foo"))
+	require.Equal(t, alice, in.keeper.GetOwner(in.ctx, "bar"))
+	for _, owned := range []struct {
+		owner sdk.AccAddress
+		names []string
+	}{{alice, []string{"bar"}}, {bob, []string{"foo"}}, {carol, []string{"baz"}}} {
+		names, _ := in.keeper.GetNamesByOwner(in.ctx, owned.owner, "", 10)
+		require.Equal(t, owned.names, names)
+	}
+	require.Equal(t, alice.String(), in.keeper.ResolveName(in.ctx, "foo"))
+	require.Equal(t, bob.String(), in.keeper.ResolveName(in.ctx, "bar"))
+
+	// The primary names of the former owners are gone, the others stay
+	_, found := in.keeper.GetPrimaryName(in.ctx, alice)
+	require.False(t, found)
+	_, found = in.keeper.GetPrimaryName(in.ctx, bob)
+	require.False(t, found)
+	name, found := in.keeper.GetPrimaryName(in.ctx, carol)
+	require.True(t, found)
+	require.Equal(t, "baz", name)
+
+	// Both names are off the market
+	require.Equal(t, types.SaleTypeNotSale, in.keeper.GetSaleStaus(in.ctx, "foo").SaleType)
+	require.Equal(t, types.SaleTypeNotSale, in.keeper.GetSaleStaus(in.ctx, "bar").SaleType)
+}
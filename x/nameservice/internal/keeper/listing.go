@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// isListingScheduled returns whether a sale is in the listing queue, which is
+// the case of a normal sale with a listing expiry
+func isListingScheduled(status types.SaleStatus) bool {
+	return status.SaleType == types.SaleTypeNormal && status.ListingExpiry > 0
+}
+
+// insertListingQueue schedules the end of a listing at its expiry
+func (k Keeper) insertListingQueue(ctx sdk.Context, name string, status types.SaleStatus) {
+	if !isListingScheduled(status) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ListingQueueKey(status.ListingExpiry, name), []byte{})
+}
+
+// removeFromListingQueue unschedules the end of a listing, if any
+func (k Keeper) removeFromListingQueue(ctx sdk.Context, name string, status types.SaleStatus) {
+	if !isListingScheduled(status) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ListingQueueKey(status.ListingExpiry, name))
+}
+
+// ListingQueueIterator returns an iterator over all listings expiring at or
+// before the given height
+func (k Keeper) ListingQueueIterator(ctx sdk.Context, height int64) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.ListingQueueKeyPrefix, sdk.PrefixEndBytes(types.ListingQueueHeightKey(height)))
+}
+
+// ExpireListings takes every normal sale whose listing expires at the given
+// height off the market. It returns the number of listings expired.
+func (k Keeper) ExpireListings(ctx sdk.Context, height int64) int {
+	var names []string
+	it := k.ListingQueueIterator(ctx, height)
+	for ; it.Valid(); it.Next() {
+		_, name := types.SplitListingQueueKey(it.Key())
+		names = append(names, name)
+	}
+	it.Close()
+
+	for _, name := range names {
+		whois := k.GetWhois(ctx, name)
+		price := whois.SaleStatus.Price
+		whois.SaleStatus = types.SaleStatus{
+			SaleType: types.SaleTypeNotSale,
+			Price:    price,
+		}
+		k.SetWhois(ctx, name, whois)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeListingExpired,
+				sdk.NewAttribute(types.AttributeKeyName, name),
+				sdk.NewAttribute(types.AttributeKeyOwner, whois.Owner.String()),
+				sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+				types.NewHeightAttribute(height),
+			),
+		)
+	}
+
+	return len(names)
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// GetOffer - gets the offer of a buyer on a name, if any
+func (k Keeper) GetOffer(ctx sdk.Context, name string, buyer sdk.AccAddress) (types.Offer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.OfferKey(name, buyer))
+	if bz == nil {
+		return types.Offer{}, false
+	}
+	var offer types.Offer
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &offer)
+	return offer, true
+}
+
+// SetOffer - stores an offer, replacing any earlier offer of its buyer on the
+// name, and schedules its refund at its expiry height
+func (k Keeper) SetOffer(ctx sdk.Context, offer types.Offer) {
+	k.DeleteOffer(ctx, offer.Name, offer.Buyer)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OfferKey(offer.Name, offer.Buyer), k.cdc.MustMarshalBinaryLengthPrefixed(offer))
+	store.Set(types.OfferQueueKey(offer.ExpiryHeight, offer.Name, offer.Buyer), []byte{})
+}
+
+// DeleteOffer - removes the offer of a buyer on a name along with its
+// scheduled refund
+func (k Keeper) DeleteOffer(ctx sdk.Context, name string, buyer sdk.AccAddress) {
+	offer, found := k.GetOffer(ctx, name, buyer)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OfferKey(name, buyer))
+	store.Delete(types.OfferQueueKey(offer.ExpiryHeight, name, buyer))
+}
+
+// GetOffers - gets all offers on a name
+func (k Keeper) GetOffers(ctx sdk.Context, name string) []types.Offer {
+	var offers []types.Offer
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.OffersKey(name))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var offer types.Offer
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &offer)
+		offers = append(offers, offer)
+	}
+	return offers
+}
+
+// IterateOffers - iterates over all offers, stopping when the callback
+// returns true
+func (k Keeper) IterateOffers(ctx sdk.Context, cb func(offer types.Offer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.OfferKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var offer types.Offer
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(it.Value(), &offer)
+		if cb(offer) {
+			return
+		}
+	}
+}
+
+// MakeOffer - escrows an offer in the module account. An earlier offer of the
+// buyer on the name is refunded and replaced.
+func (k Keeper) MakeOffer(ctx sdk.Context, offer types.Offer) error {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, offer.Buyer, types.ModuleName, offer.Price); err != nil {
+		return err
+	}
+	if earlier, found := k.GetOffer(ctx, offer.Name, offer.Buyer); found {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, earlier.Buyer, earlier.Price); err != nil {
+			return err
+		}
+	}
+	k.SetOffer(ctx, offer)
+	return nil
+}
+
+// AcceptOffer - pays the escrowed offer of a buyer to the owner of the name
+// and hands the name over to the buyer, taking it off the market. An offer
+// reaching its expiry height is only refunded. The other offers on the name
+// stay open to the new owner. It returns the offer accepted.
+func (k Keeper) AcceptOffer(ctx sdk.Context, name string, buyer sdk.AccAddress) (types.Offer, error) {
+	offer, found := k.GetOffer(ctx, name, buyer)
+	if !found {
+		return types.Offer{}, sdkerrors.Wrap(types.ErrOfferNotFound, buyer.String())
+	}
+	if offer.IsExpired(ctx.BlockHeight()) {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrOfferExpired, "offer of %s expired at %d", buyer, offer.ExpiryHeight)
+	}
+	whois := k.GetWhois(ctx, name)
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, whois.Owner, offer.Price); err != nil {
+		return types.Offer{}, err
+	}
+	k.DeleteOffer(ctx, name, buyer)
+
+	whois.Owner = buyer
+	whois.Price = offer.Price
+	whois.SaleStatus = types.SaleStatus{
+		SaleType: types.SaleTypeNotSale,
+	}
+	k.SetWhois(ctx, name, whois)
+	return offer, nil
+}
+
+// RefundExpiredOffers refunds every offer whose expiry height is reached at
+// the given height. It returns the number of offers refunded.
+func (k Keeper) RefundExpiredOffers(ctx sdk.Context, height int64) int {
+	var offers []types.Offer
+	store := ctx.KVStore(k.storeKey)
+	it := store.Iterator(types.OfferQueueKeyPrefix, sdk.PrefixEndBytes(types.OfferQueueHeightKey(height)))
+	for ; it.Valid(); it.Next() {
+		_, name, buyer := types.SplitOfferQueueKey(it.Key())
+		if offer, found := k.GetOffer(ctx, name, buyer); found && offer.IsExpired(height) {
+			offers = append(offers, offer)
+		}
+	}
+	it.Close()
+
+	refunded := 0
+	for _, offer := range offers {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, offer.Buyer, offer.Price); err != nil {
+			k.Logger(ctx).Error("failed to refund expired offer", "name", offer.Name, "buyer", offer.Buyer, "err", err)
+			continue
+		}
+		k.DeleteOffer(ctx, offer.Name, offer.Buyer)
+		refunded++
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOfferExpired,
+				sdk.NewAttribute(types.AttributeKeyName, offer.Name),
+				sdk.NewAttribute(types.AttributeKeyBuyer, offer.Buyer.String()),
+				sdk.NewAttribute(types.AttributeKeyPrice, offer.Price.String()),
+				types.NewHeightAttribute(height),
+			),
+		)
+	}
+
+	return refunded
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+func TestOffers(t *testing.T) {
+	const seller, alice, bob = 0, 1, 2
+	cases := []struct {
+		name string
+		// run makes and accepts offers, then refunds the expired ones
+		run      func(t *testing.T, in testInput, accs []sdk.AccAddress)
+		owner    int
+		balances []int64
+		escrow   int64
+	}{
+		{
+			name: "replaced offer refunded",
+			run: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				require.NoError(t, in.keeper.MakeOffer(in.ctx, types.NewOffer("foo", accs[alice], coins(100), 20)))
+				require.NoError(t, in.keeper.MakeOffer(in.ctx, types.NewOffer("foo", accs[alice], coins(150), 30)))
+				require.Equal(t, []int64{0, 850, 1000}, in.balances(accs))
+				require.Equal(t, []types.Offer{types.NewOffer("foo", accs[alice], coins(150), 30)}, in.keeper.GetOffers(in.ctx, "foo"))
+
+				// The expiry of the replaced offer no longer refunds anything
+				require.Equal(t, 0, in.keeper.RefundExpiredOffers(in.ctx, 20))
+				require.Equal(t, int64(150), in.escrow())
+				require.Equal(t, 1, in.keeper.RefundExpiredOffers(in.ctx, 30))
+			},
+			owner:    seller,
+			balances: []int64{0, 1000, 1000},
+		},
+		{
+			name: "accepted offer paid to the owner and the others left open",
+			run: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				require.NoError(t, in.keeper.MakeOffer(in.ctx, types.NewOffer("foo", accs[alice], coins(100), 20)))
+				require.NoError(t, in.keeper.MakeOffer(in.ctx, types.NewOffer("foo", accs[bob], coins(200), 20)))
+				offer, err := in.keeper.AcceptOffer(in.ctx.WithBlockHeight(19), "foo", accs[bob])
+				require.NoError(t, err)
+				require.Equal(t, coins(200), offer.Price)
+				require.Equal(t, []types.Offer{types.NewOffer("foo", accs[alice], coins(100), 20)}, in.keeper.GetOffers(in.ctx, "foo"))
+			},
+			owner:    bob,
+			balances: []int64{200, 900, 800},
+			escrow:   100,
+		},
+		{
+			name: "offer refunded at its expiry height instead of accepted",
+			run: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				require.NoError(t, in.keeper.MakeOffer(in.ctx, types.NewOffer("foo", accs[alice], coins(100), 20)))
+				_, err := in.keeper.AcceptOffer(in.ctx.WithBlockHeight(20), "foo", accs[alice])
+				require.True(t, types.ErrOfferExpired.Is(err), "%v", err)
+				require.Equal(t, 0, in.keeper.RefundExpiredOffers(in.ctx, 19))
+				require.Equal(t, 1, in.keeper.RefundExpiredOffers(in.ctx, 20))
+			},
+			owner:    seller,
+			balances: []int64{0, 1000, 1000},
+		},
+		{
+			name: "offer not found",
+			run: func(t *testing.T, in testInput, accs []sdk.AccAddress) {
+				_, err := in.keeper.AcceptOffer(in.ctx, "foo", accs[alice])
+				require.True(t, types.ErrOfferNotFound.Is(err), "%v", err)
+			},
+			owner:    seller,
+			balances: []int64{0, 1000, 1000},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			accs := []sdk.AccAddress{in.newTestAccount(t, 0), in.newTestAccount(t, 1000), in.newTestAccount(t, 1000)}
+			in.registerName("foo", accs[seller])
+
+			tc.run(t, in, accs)
+			require.Equal(t, accs[tc.owner], in.keeper.GetOwner(in.ctx, "foo"))
+			require.Equal(t, tc.balances, in.balances(accs))
+			require.Equal(t, tc.escrow, in.escrow())
+		})
+	}
+}
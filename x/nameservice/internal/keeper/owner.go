@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// GetNamesByOwnerIterator - gets an iterator over the names owned by an
+// address, starting right after the given name
+func (k Keeper) GetNamesByOwnerIterator(ctx sdk.Context, owner sdk.AccAddress, startAfter string) sdk.Iterator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OwnerNamesKey(owner))
+	var start []byte
+	if startAfter != "" {
+		start = append([]byte(startAfter), 0x00)
+	}
+	return store.Iterator(start, nil)
+}
+
+// GetNamesByOwner - gets a page of the names owned by an address along with
+// the cursor of the next page, if any
+func (k Keeper) GetNamesByOwner(ctx sdk.Context, owner sdk.AccAddress, startAfter string, limit int) (names []string, next string) {
+	it := k.GetNamesByOwnerIterator(ctx, owner, startAfter)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if len(names) == limit {
+			return names, names[len(names)-1]
+		}
+		names = append(names, string(it.Key()))
+	}
+	return names, ""
+}
+
+// insertOwner indexes a name under its owner
+func (k Keeper) insertOwner(ctx sdk.Context, name string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OwnerNameKey(owner, name), []byte{})
+}
+
+// removeOwner removes a name from the index of its owner
+func (k Keeper) removeOwner(ctx sdk.Context, name string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OwnerNameKey(owner, name))
+}
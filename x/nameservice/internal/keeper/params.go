@@ -1,11 +1,8 @@
 package keeper
-/*
-// TODO: Define if your module needs Parameters, if not this can be deleted
 
 import (
-	"time"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
 )
 
@@ -19,4 +16,19 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramspace.SetParamSet(ctx, &params)
 }
-*/
\ No newline at end of file
+
+// ValidateValue checks that a value fits within the max value length param
+func (k Keeper) ValidateValue(ctx sdk.Context, value string) error {
+	if maxLength := k.GetParams(ctx).MaxValueLength; int64(len(value)) > maxLength {
+		return sdkerrors.Wrapf(types.ErrValueTooLong, "%d > %d", len(value), maxLength)
+	}
+	return nil
+}
+
+// ValidateDenoms checks that a price or a bid only uses allowed denoms
+func (k Keeper) ValidateDenoms(ctx sdk.Context, coins sdk.Coins) error {
+	if !k.GetParams(ctx).IsDenomAllowed(coins) {
+		return sdkerrors.Wrap(types.ErrDenomNotAllowed, coins.String())
+	}
+	return nil
+}
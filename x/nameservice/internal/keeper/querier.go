@@ -15,6 +15,12 @@ const (
 	QueryWhois      = "whois"
 	QueryNames      = "names"
 	QuerySaleStatus = "sale_status"
+	QueryPriceQuote = "price_quote"
+	QuerySubdomains = "subdomains"
+	QueryReverse    = "reverse"
+	QueryOwnerNames = "names_by_owner"
+	QueryParams     = "params"
+	QueryOffers     = "offers"
 )
 
 // NewQuerier is the module level router for state queries
@@ -29,6 +35,18 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryNames(ctx, req, keeper)
 		case QuerySaleStatus:
 			return querySaleStatus(ctx, path[1:], req, keeper)
+		case QueryPriceQuote:
+			return queryPriceQuote(ctx, path[1:], req, keeper)
+		case QuerySubdomains:
+			return querySubdomains(ctx, path[1:], req, keeper)
+		case QueryReverse:
+			return queryReverse(ctx, path[1:], req, keeper)
+		case QueryOwnerNames:
+			return queryNamesByOwner(ctx, req, keeper)
+		case QueryParams:
+			return queryParams(ctx, keeper)
+		case QueryOffers:
+			return queryOffers(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown nameservice query endpoint")
 		}
@@ -37,13 +55,32 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 // nolint: unparam
 func queryResolve(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
-	value := keeper.ResolveName(ctx, path[0])
+	name := path[0]
+	resolved := types.QueryResResolve{Expiry: keeper.GetExpiry(ctx, name)}
 
-	if value == "" {
+	// resolve/{name} returns the value of the name, resolve/{name}/{type}[/{key}] one of its typed records
+	if len(path) > 1 {
+		resolved.Type = path[1]
+		if len(path) > 2 {
+			resolved.Key = path[2]
+		}
+		if err := types.ValidateRecordKey(resolved.Type, resolved.Key); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidRecord, err.Error())
+		}
+		value, found := keeper.ResolveRecord(ctx, name, resolved.Type, resolved.Key)
+		if !found {
+			return []byte{}, sdkerrors.Wrap(types.ErrRecordNotFound, "could not resolve record")
+		}
+		resolved.Value = value
+	} else {
+		resolved.Value = keeper.ResolveName(ctx, name)
+	}
+
+	if resolved.Value == "" {
 		return []byte{}, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "could not resolve name")
 	}
 
-	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResResolve{Value: value})
+	res, err := codec.MarshalJSONIndent(keeper.cdc, resolved)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -54,6 +91,7 @@ func queryResolve(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 // nolint: unparam
 func queryWhois(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	whois := keeper.GetWhois(ctx, path[0])
+	whois.SaleStatus = whois.SaleStatus.Public()
 
 	res, err := codec.MarshalJSONIndent(keeper.cdc, whois)
 	if err != nil {
@@ -64,15 +102,26 @@ func queryWhois(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 }
 
 func queryNames(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
-	var namesList types.QueryResNames
-
-	iterator := keeper.GetNamesIterator(ctx)
-
-	for ; iterator.Valid(); iterator.Next() {
-		namesList = append(namesList, string(iterator.Key()))
+	var params types.QueryNamesParams
+	if len(req.Data) > 0 {
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+	for _, saleType := range params.SaleTypes {
+		if !types.IsSaleTypeValid(saleType) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown sale type %d", saleType)
+		}
 	}
 
-	res, err := codec.MarshalJSONIndent(keeper.cdc, namesList)
+	names, next := keeper.GetNamesPage(ctx, params, types.PageLimit(params.Limit))
+	var bundled []types.QueryResBundled
+	for _, name := range names {
+		if bundle, found := keeper.GetBundle(ctx, name); found {
+			bundled = append(bundled, types.QueryResBundled{Name: name, Bundle: bundle})
+		}
+	}
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResNamesPage{Names: names, NextCursor: next, Bundled: bundled})
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -82,15 +131,49 @@ func queryNames(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 
 // nolint: unparam
 func querySaleStatus(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
-	status := keeper.GetSaleStaus(ctx, path[0])
+	// A bundled name is on the sale of the name leading its bundle
+	name := path[0]
+	if lead, found := keeper.GetBundle(ctx, name); found {
+		name = lead
+	}
+	status := keeper.GetSaleStaus(ctx, name)
+	var bundle []string
+	if len(status.Bundle) > 0 {
+		bundle = append([]string{name}, status.Bundle...)
+	}
 	bidPriceStr := ""
-	if len(status.Bids) > 0 {
+	if status.SaleType == types.SaleTypeSealedAuction {
+		// Sealed bids stay hidden until they are revealed
+		if i, found := status.HighestRevealedBid(); found {
+			bidPriceStr = status.Bids[i].Price.String()
+		}
+	} else if len(status.Bids) > 0 {
 		bidPriceStr = status.Bids[len(status.Bids)-1].Price.String()
 	}
+	// A second price auction clears at the runner-up bid, known for sure once settled
+	clearingPrice := status.ClearingPrice
+	if status.SaleType == types.SaleTypeSecondPriceAuction {
+		clearingPrice = status.Public().SettlementPrice()
+	}
+	// The price of a dutch auction falls block by block
+	var currentPrice, floorPrice sdk.Coins
+	if status.SaleType == types.SaleTypeDutchAuction {
+		currentPrice, floorPrice = status.DutchPriceAt(ctx.BlockHeight()), status.FloorPrice
+	}
 	retStatus := types.QuerySaleStatus{
-		SaleType: status.SaleType,
-		Price:    status.Price.String(),
-		BidPrice: bidPriceStr,
+		SaleType:        status.SaleType,
+		Price:           status.Price.String(),
+		BidPrice:        bidPriceStr,
+		ClearingPrice:   clearingPrice.String(),
+		CurrentPrice:    currentPrice.String(),
+		FloorPrice:      floorPrice.String(),
+		CommitEndHeight: status.CommitEndHeight,
+		EndHeight:       status.EndHeight,
+		Extensions:      status.Extensions,
+		ReservePrice:    status.Public().ReservePrice.String(),
+		AllowedBuyers:   status.AllowedBuyers,
+		ListingExpiry:   status.ListingExpiry,
+		Bundle:          bundle,
 	}
 
 	res, err := codec.MarshalJSONIndent(keeper.cdc, retStatus)
@@ -100,3 +183,90 @@ func querySaleStatus(ctx sdk.Context, path []string, req abci.RequestQuery, keep
 
 	return res, nil
 }
+
+// nolint: unparam
+func queryPriceQuote(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	name := path[0]
+	if keeper.IsExpired(ctx, name) {
+		return nil, sdkerrors.Wrap(types.ErrNameExpired, name)
+	}
+
+	price, premium := keeper.GetPriceQuote(ctx, name)
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResPriceQuote{Price: price, Premium: premium})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func querySubdomains(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	namesList := types.QueryResNames(keeper.GetSubdomains(ctx, path[0]))
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, namesList)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryReverse(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	addr, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	name, found := keeper.GetPrimaryName(ctx, addr)
+	if !found {
+		return []byte{}, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no primary name for address")
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResReverse{Name: name})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryNamesByOwner(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryNamesByOwnerParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Owner.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner cannot be empty")
+	}
+
+	names, next := keeper.GetNamesByOwner(ctx, params.Owner, params.StartAfter, types.PageLimit(params.Limit))
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResNamesPage{Names: names, NextCursor: next})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryOffers(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	offers := types.QueryResOffers(keeper.GetOffers(ctx, path[0]))
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, offers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
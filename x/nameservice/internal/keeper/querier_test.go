@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+func TestQuerySaleStatusReserve(t *testing.T) {
+	cases := []struct {
+		name string
+		// hidden tells whether the reserve price is hidden until the auction ends
+		hidden bool
+		// reserve and clearing price shown while the auction runs
+		reserve, clearingPrice string
+	}{
+		{"hidden reserve price redacted", true, "", "100nametoken"},
+		{"public reserve price shown", false, "150nametoken", "150nametoken"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			seller, alice, bob := in.newTestAccount(t, 0), in.newTestAccount(t, 1000), in.newTestAccount(t, 1000)
+			in.registerName("foo", seller)
+			require.NoError(t, in.keeper.SetSale(in.ctx, "foo", types.SaleTypeSecondPriceAuction, coins(10)))
+			in.keeper.SetReservePrice(in.ctx, "foo", coins(150), tc.hidden)
+			require.NoError(t, in.keeper.AddBid(in.ctx, "foo", alice, coins(100), nil))
+			require.NoError(t, in.keeper.AddBid(in.ctx, "foo", bob, coins(200), nil))
+
+			querier := NewQuerier(in.keeper)
+			querySaleStatus := func() types.QuerySaleStatus {
+				bz, err := querier(in.ctx, []string{QuerySaleStatus, "foo"}, abci.RequestQuery{})
+				require.NoError(t, err)
+				var status types.QuerySaleStatus
+				in.keeper.cdc.MustUnmarshalJSON(bz, &status)
+				return status
+			}
+
+			status := querySaleStatus()
+			require.Equal(t, tc.reserve, status.ReservePrice)
+			// The runner-up bid is not raised to a hidden reserve, which would give it away
+			require.Equal(t, tc.clearingPrice, status.ClearingPrice)
+
+			bz, err := querier(in.ctx, []string{QueryWhois, "foo"}, abci.RequestQuery{})
+			require.NoError(t, err)
+			var whois types.Whois
+			in.keeper.cdc.MustUnmarshalJSON(bz, &whois)
+			require.Equal(t, tc.reserve, whois.SaleStatus.ReservePrice.String())
+
+			// The reserve price is on record once the auction ended
+			in.settle("foo")
+			status = querySaleStatus()
+			require.Equal(t, "150nametoken", status.ReservePrice)
+			require.Equal(t, "150nametoken", status.ClearingPrice)
+			require.Equal(t, bob, in.keeper.GetOwner(in.ctx, "foo"))
+		})
+	}
+}
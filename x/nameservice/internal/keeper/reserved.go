@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// SetReserved - reserves a name so that it can no longer be registered
+func (k Keeper) SetReserved(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReservedKey(name), []byte{})
+}
+
+// IsReserved - returns whether a name is reserved
+func (k Keeper) IsReserved(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ReservedKey(name))
+}
+
+// DeleteReserved - lifts the reservation of a name
+func (k Keeper) DeleteReserved(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ReservedKey(name))
+}
+
+// IterateReservedNames - iterates over all reserved names, stopping when the
+// callback returns true
+func (k Keeper) IterateReservedNames(ctx sdk.Context, cb func(name string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ReservedKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if cb(string(it.Key()[len(types.ReservedKeyPrefix):])) {
+			return
+		}
+	}
+}
+
+// SeizeName - hands a name over to a new owner, refunding the bids of its
+// sale and clearing what it resolves to. A name that is not registered, or
+// whose term is over, starts a new term. Any reservation of the name is
+// lifted.
+func (k Keeper) SeizeName(ctx sdk.Context, name string, newOwner sdk.AccAddress) error {
+	if err := k.RefundBids(ctx, name); err != nil {
+		return err
+	}
+
+	whois := k.GetWhois(ctx, name)
+	if whois.Owner.Empty() || whois.IsExpired(ctx.BlockHeight()) {
+		whois.Expiry = ctx.BlockHeight() + k.GetParams(ctx).RegistrationDuration
+	}
+	whois.Owner = newOwner
+	whois.Value = ""
+	whois.Records = types.Records{}
+	whois.SaleStatus = types.SaleStatus{
+		SaleType: types.SaleTypeNotSale,
+	}
+	k.SetWhois(ctx, name, whois)
+	k.DeleteReserved(ctx, name)
+	return nil
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// SetPrimaryName - sets the canonical name of an address
+func (k Keeper) SetPrimaryName(ctx sdk.Context, addr sdk.AccAddress, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReverseKey(addr), []byte(name))
+}
+
+// GetPrimaryName - gets the canonical name of an address, unless the name
+// has expired
+func (k Keeper) GetPrimaryName(ctx sdk.Context, addr sdk.AccAddress) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReverseKey(addr))
+	if bz == nil || k.IsExpired(ctx, string(bz)) {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// DeletePrimaryName - removes the canonical name of an address
+func (k Keeper) DeletePrimaryName(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ReverseKey(addr))
+}
+
+// IsPrimaryNameValid - returns whether a name can be the canonical name of an
+// address, which requires the address to own the name and the name to
+// resolve to the address
+func (k Keeper) IsPrimaryNameValid(ctx sdk.Context, addr sdk.AccAddress, name string) bool {
+	if !k.IsNamePresent(ctx, name) {
+		return false
+	}
+	whois := k.GetWhois(ctx, name)
+	return addr.Equals(whois.Owner) && whois.ResolvesTo(addr)
+}
+
+// invalidatePrimaryName removes the reverse record of the previous owner of a
+// name once the name is sold, deleted or resolves somewhere else
+func (k Keeper) invalidatePrimaryName(ctx sdk.Context, name string, prevOwner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if string(store.Get(types.ReverseKey(prevOwner))) != name {
+		return
+	}
+	if !k.IsPrimaryNameValid(ctx, prevOwner, name) {
+		k.DeletePrimaryName(ctx, prevOwner)
+	}
+}
+
+// IteratePrimaryNames - iterates over all reverse records, stopping once the
+// callback returns true
+func (k Keeper) IteratePrimaryNames(ctx sdk.Context, cb func(addr sdk.AccAddress, name string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ReverseKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		addr := sdk.AccAddress(it.Key()[len(types.ReverseKeyPrefix):])
+		if cb(addr, string(it.Value())) {
+			break
+		}
+	}
+}
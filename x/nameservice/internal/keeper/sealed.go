@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// CommitBid - escrows the deposit of a sealed bid in the module account and
+// records its commitment
+func (k Keeper) CommitBid(ctx sdk.Context, name string, buyer sdk.AccAddress, deposit sdk.Coins, commitment string) error {
+	whois := k.GetWhois(ctx, name)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, deposit); err != nil {
+		return err
+	}
+
+	whois.SaleStatus.Bids = append(whois.SaleStatus.Bids, types.Bid{
+		Buyer:       buyer,
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockHeader().Time.Unix(),
+		Commitment:  commitment,
+		Deposit:     deposit,
+	})
+	k.SetWhois(ctx, name, whois)
+	return nil
+}
+
+// RevealBid - reveals the sealed bid of a buyer, which must match its
+// commitment and be covered by its deposit
+func (k Keeper) RevealBid(ctx sdk.Context, name string, buyer sdk.AccAddress, bid sdk.Coins, salt string) error {
+	whois := k.GetWhois(ctx, name)
+	i, found := whois.SaleStatus.FindBid(buyer)
+	if !found {
+		return sdkerrors.Wrap(types.ErrBidNotFound, buyer.String())
+	}
+	sealed := whois.SaleStatus.Bids[i]
+	if sealed.Revealed {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bid already revealed")
+	}
+	if types.SealedBidCommitment(name, buyer, bid, salt) != sealed.Commitment {
+		return types.ErrInvalidReveal
+	}
+	if !sealed.Deposit.IsAllGTE(bid) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "bid %s is not covered by deposit %s", bid, sealed.Deposit)
+	}
+
+	whois.SaleStatus.Bids[i].Price = bid
+	whois.SaleStatus.Bids[i].Revealed = true
+	k.SetWhois(ctx, name, whois)
+	return nil
+}
+
+// refundDeposits returns the escrowed deposit of every sealed bid, or of every
+// outbid open bid
+func (k Keeper) refundDeposits(ctx sdk.Context, status types.SaleStatus) error {
+	for _, bid := range status.Bids {
+		if bid.Deposit.IsZero() {
+			continue
+		}
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Buyer, bid.Deposit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finishSealedAuction settles a sealed bid auction at the end of its reveal
+// phase. The highest revealed bid reaching the sale price wins: it is paid
+// to the seller and the rest of its deposit is refunded. The deposits of the
+// other revealed bids are refunded while the deposits of unrevealed bids are
+// forfeited and burned. A winning bid failing to settle loses the
+// DefaultPenalty share of its deposit and the next best one is tried. Without
+// a winner the name stays with the seller. It returns whether the name was
+// sold.
+func (k Keeper) finishSealedAuction(ctx sdk.Context, name string, whois types.Whois, height int64) bool {
+	status := whois.SaleStatus
+	// Deposits of defaulted bids are already dealt with by their penalty
+	defaulted := make([]bool, len(status.Bids))
+	for _, winner := range status.SettlementCandidates() {
+		cacheCtx, write := ctx.CacheContext()
+		err := k.settleSealedBids(cacheCtx, name, whois, winner, defaulted)
+		if err == nil {
+			write()
+			k.emitSealedSettlement(ctx, name, whois, winner, defaulted, height)
+			return true
+		}
+		bid := status.Bids[winner]
+		k.settlementFailed(ctx, name, whois, bid, err, height)
+		k.penalizeDeposit(ctx, name, bid.Buyer, bid.Deposit, k.GetParams(ctx).DefaultPenaltyOf(bid.Deposit), height)
+		defaulted[winner] = true
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.settleSealedBids(cacheCtx, name, whois, -1, defaulted); err != nil {
+		k.settlementFailed(ctx, name, whois, types.Bid{}, err, height)
+		k.endUnsold(ctx, name, "deposits left in escrow", height)
+		return false
+	}
+	write()
+	k.emitSealedSettlement(ctx, name, whois, -1, defaulted, height)
+	return false
+}
+
+// settleSealedBids pays the winning bid, if any, to the seller, releases the
+// deposits of the other bids but the defaulted ones and hands the name over
+// to the winner, all or nothing
+func (k Keeper) settleSealedBids(ctx sdk.Context, name string, whois types.Whois, winner int, defaulted []bool) error {
+	seller, status := whois.Owner, whois.SaleStatus
+	forfeited := sdk.NewCoins()
+	for i, bid := range status.Bids {
+		switch {
+		case defaulted[i]:
+			continue
+		case !bid.Revealed:
+			forfeited = forfeited.Add(bid.Deposit...)
+		case i == winner:
+			if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, seller, bid.Price); err != nil {
+				return err
+			}
+			if change := bid.Deposit.Sub(bid.Price); !change.IsZero() {
+				if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Buyer, change); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Buyer, bid.Deposit); err != nil {
+				return err
+			}
+		}
+	}
+	if !forfeited.IsZero() {
+		if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, forfeited); err != nil {
+			return err
+		}
+	}
+
+	if winner >= 0 {
+		whois.Owner = status.Bids[winner].Buyer
+		whois.Price = status.Bids[winner].Price
+	}
+	whois.SaleStatus = types.SaleStatus{
+		SaleType: types.SaleTypeNotSale,
+	}
+	k.SetWhois(ctx, name, whois)
+	return nil
+}
+
+// emitSealedSettlement emits the events of a settled sealed bid auction
+func (k Keeper) emitSealedSettlement(ctx sdk.Context, name string, whois types.Whois, winner int, defaulted []bool, height int64) {
+	seller, status := whois.Owner, whois.SaleStatus
+	for i, bid := range status.Bids {
+		if !bid.Revealed && !defaulted[i] {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBidForfeited,
+					sdk.NewAttribute(types.AttributeKeyName, name),
+					sdk.NewAttribute(types.AttributeKeyBuyer, bid.Buyer.String()),
+					sdk.NewAttribute(types.AttributeKeyPrice, bid.Deposit.String()),
+					types.NewHeightAttribute(height),
+				),
+			)
+		}
+	}
+	if winner < 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAuctionUnsold,
+				sdk.NewAttribute(types.AttributeKeyName, name),
+				sdk.NewAttribute(types.AttributeKeySeller, seller.String()),
+				types.NewHeightAttribute(height),
+			),
+		)
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionSettled,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeySeller, seller.String()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, status.Bids[winner].Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, status.Bids[winner].Price.String()),
+			types.NewHeightAttribute(height),
+		),
+	)
+}
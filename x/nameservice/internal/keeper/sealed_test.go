@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+const testSalt = "salt"
+
+// commitStep commits a sealed bid with a deposit, revealing it later if asked
+// to. Accounts are numbered from the seller on.
+type commitStep struct {
+	bidder       int
+	deposit, bid int64
+	reveal       bool
+}
+
+// commitSealedBid commits the sealed bid of a buyer with the test salt
+func (in testInput) commitSealedBid(t *testing.T, name string, buyer sdk.AccAddress, deposit, bid int64) {
+	commitment := types.SealedBidCommitment(name, buyer, coins(bid), testSalt)
+	require.NoError(t, in.keeper.CommitBid(in.ctx, name, buyer, coins(deposit), commitment))
+}
+
+func TestRevealBid(t *testing.T) {
+	cases := []struct {
+		name   string
+		reveal func(t *testing.T, in testInput, alice, bob sdk.AccAddress) error
+		err    *sdkerrors.Error
+		// price revealed for the bid of alice, zero while it stays sealed
+		price int64
+	}{
+		{
+			name: "matching reveal accepted",
+			reveal: func(t *testing.T, in testInput, alice, bob sdk.AccAddress) error {
+				return in.keeper.RevealBid(in.ctx, "foo", alice, coins(200), testSalt)
+			},
+			price: 200,
+		},
+		{
+			name: "wrong salt rejected",
+			reveal: func(t *testing.T, in testInput, alice, bob sdk.AccAddress) error {
+				return in.keeper.RevealBid(in.ctx, "foo", alice, coins(200), "pepper")
+			},
+			err: types.ErrInvalidReveal,
+		},
+		{
+			name: "other bid than committed rejected",
+			reveal: func(t *testing.T, in testInput, alice, bob sdk.AccAddress) error {
+				return in.keeper.RevealBid(in.ctx, "foo", alice, coins(100), testSalt)
+			},
+			err: types.ErrInvalidReveal,
+		},
+		{
+			name: "commitment replayed by another bidder rejected",
+			reveal: func(t *testing.T, in testInput, alice, bob sdk.AccAddress) error {
+				commitment := in.keeper.GetSaleStaus(in.ctx, "foo").Bids[0].Commitment
+				require.NoError(t, in.keeper.CommitBid(in.ctx, "foo", bob, coins(300), commitment))
+				return in.keeper.RevealBid(in.ctx, "foo", bob, coins(200), testSalt)
+			},
+			err: types.ErrInvalidReveal,
+		},
+		{
+			name: "bid over the deposit rejected",
+			reveal: func(t *testing.T, in testInput, alice, bob sdk.AccAddress) error {
+				in.commitSealedBid(t, "foo", bob, 100, 200)
+				return in.keeper.RevealBid(in.ctx, "foo", bob, coins(200), testSalt)
+			},
+			err: sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name: "second reveal rejected",
+			reveal: func(t *testing.T, in testInput, alice, bob sdk.AccAddress) error {
+				if err := in.keeper.RevealBid(in.ctx, "foo", alice, coins(200), testSalt); err != nil {
+					return err
+				}
+				return in.keeper.RevealBid(in.ctx, "foo", alice, coins(200), testSalt)
+			},
+			err:   sdkerrors.ErrInvalidRequest,
+			price: 200,
+		},
+		{
+			name: "reveal without a commitment rejected",
+			reveal: func(t *testing.T, in testInput, alice, bob sdk.AccAddress) error {
+				return in.keeper.RevealBid(in.ctx, "foo", bob, coins(200), testSalt)
+			},
+			err: types.ErrBidNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			seller := in.newTestAccount(t, 0)
+			alice, bob := in.newTestAccount(t, 1000), in.newTestAccount(t, 1000)
+			in.registerName("foo", seller)
+			require.NoError(t, in.keeper.SetSale(in.ctx, "foo", types.SaleTypeSealedAuction, coins(10)))
+			in.commitSealedBid(t, "foo", alice, 300, 200)
+
+			err := tc.reveal(t, in, alice, bob)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, tc.err.Is(err), "expected %v, got %v", tc.err, err)
+			}
+
+			// The committed bid of alice is only revealed by a matching reveal
+			bid := in.keeper.GetSaleStaus(in.ctx, "foo").Bids[0]
+			require.Equal(t, tc.price != 0, bid.Revealed)
+			if tc.price != 0 {
+				require.Equal(t, coins(tc.price), bid.Price)
+			}
+			require.Equal(t, coins(300), bid.Deposit)
+		})
+	}
+}
+
+func TestFinishSealedAuction(t *testing.T) {
+	const seller, alice, bob, carol = 0, 1, 2, 3
+	cases := []struct {
+		name     string
+		commits  []commitStep
+		owner    int
+		balances []int64
+		burned   int64
+	}{
+		{
+			name: "highest revealed bid wins and losing bidders are refunded",
+			commits: []commitStep{
+				{bidder: alice, deposit: 300, bid: 200, reveal: true},
+				{bidder: bob, deposit: 500, bid: 250, reveal: true},
+				{bidder: carol, deposit: 100, bid: 100, reveal: true},
+			},
+			owner:    bob,
+			balances: []int64{250, 1000, 750, 1000},
+		},
+		{
+			name: "tie goes to the earlier bid",
+			commits: []commitStep{
+				{bidder: alice, deposit: 200, bid: 200, reveal: true},
+				{bidder: bob, deposit: 200, bid: 200, reveal: true},
+			},
+			owner:    alice,
+			balances: []int64{200, 800, 1000, 1000},
+		},
+		{
+			name: "unrevealed deposits are burned",
+			commits: []commitStep{
+				{bidder: alice, deposit: 300, bid: 200, reveal: true},
+				{bidder: bob, deposit: 500, bid: 400},
+				{bidder: carol, deposit: 100, bid: 100},
+			},
+			owner:    alice,
+			balances: []int64{200, 800, 500, 900},
+			burned:   600,
+		},
+		{
+			name: "bids below the sale price are refunded and the name stays unsold",
+			commits: []commitStep{
+				{bidder: alice, deposit: 300, bid: 5, reveal: true},
+				{bidder: bob, deposit: 100, bid: 100},
+			},
+			owner:    seller,
+			balances: []int64{0, 1000, 900, 1000},
+			burned:   100,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := createTestInput(t)
+			accs := []sdk.AccAddress{in.newTestAccount(t, 0), in.newTestAccount(t, 1000), in.newTestAccount(t, 1000), in.newTestAccount(t, 1000)}
+			in.registerName("foo", accs[seller])
+			require.NoError(t, in.keeper.SetSale(in.ctx, "foo", types.SaleTypeSealedAuction, coins(10)))
+
+			escrow := int64(0)
+			for _, commit := range tc.commits {
+				in.commitSealedBid(t, "foo", accs[commit.bidder], commit.deposit, commit.bid)
+				escrow += commit.deposit
+			}
+			require.Equal(t, escrow, in.escrow())
+			for _, commit := range tc.commits {
+				if commit.reveal {
+					require.NoError(t, in.keeper.RevealBid(in.ctx, "foo", accs[commit.bidder], coins(commit.bid), testSalt))
+				}
+			}
+
+			supply := in.supply()
+			in.settle("foo")
+			require.Equal(t, accs[tc.owner], in.keeper.GetOwner(in.ctx, "foo"))
+			require.Equal(t, tc.balances, in.balances(accs))
+			require.Equal(t, int64(0), in.escrow())
+			require.Equal(t, tc.burned, supply-in.supply())
+			status := in.keeper.GetSaleStaus(in.ctx, "foo")
+			require.Equal(t, types.SaleTypeNotSale, status.SaleType)
+			require.Empty(t, status.Bids)
+		})
+	}
+}
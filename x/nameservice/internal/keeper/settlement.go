@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// finishOpenAuction settles an auction with open bids, falling back to the
+// next best bid whenever one fails to settle. The leading bid is held in
+// escrow, up to its maximum price for a proxy bid, while an outbid bid only
+// holds its deposit and a fallback bidder pays the rest of its bid at
+// settlement. A defaulting bidder is penalized: the leading bidder loses the
+// DefaultPenalty share of its escrow and a fallback bidder its deposit. The
+// deposits of the other bids are refunded once the auction ends. Without any
+// bid settling, the name stays with the seller off the market. It returns
+// whether the name was sold.
+func (k Keeper) finishOpenAuction(ctx sdk.Context, name string, whois types.Whois, height int64) bool {
+	status := whois.SaleStatus
+	leading := len(status.Bids) - 1
+	// Deposits of the bids tried are either paid in or penalized
+	tried := make([]bool, len(status.Bids))
+	for _, i := range status.SettlementCandidates() {
+		bid, price := status.Bids[i], status.SettlementPriceOf(i)
+		tried[i] = true
+
+		cacheCtx, write := ctx.CacheContext()
+		err := func() error {
+			if rest := bid.Price.Sub(bid.Deposit); i != leading && !rest.IsZero() {
+				if err := k.supplyKeeper.SendCoinsFromAccountToModule(cacheCtx, bid.Buyer, types.ModuleName, rest); err != nil {
+					return err
+				}
+			}
+			return k.finishOneAuction(cacheCtx, name, bid, price)
+		}()
+		if err != nil {
+			k.settlementFailed(ctx, name, whois, bid, err, height)
+			if i == leading {
+				k.penalizeDeposit(ctx, name, bid.Buyer, bid.Escrow(), k.GetParams(ctx).DefaultPenaltyOf(bid.Escrow()), height)
+			} else {
+				k.penalizeDeposit(ctx, name, bid.Buyer, bid.Deposit, bid.Deposit, height)
+			}
+			continue
+		}
+		write()
+		k.releaseDeposits(ctx, name, status, tried, height)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAuctionSettled,
+				sdk.NewAttribute(types.AttributeKeyName, name),
+				sdk.NewAttribute(types.AttributeKeySeller, whois.Owner.String()),
+				sdk.NewAttribute(types.AttributeKeyBuyer, bid.Buyer.String()),
+				sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+				types.NewHeightAttribute(height),
+			),
+		)
+		if len(status.Bundle) > 0 {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBundleSold,
+					sdk.NewAttribute(types.AttributeKeyName, name),
+					sdk.NewAttribute(types.AttributeKeySeller, whois.Owner.String()),
+					sdk.NewAttribute(types.AttributeKeyBuyer, bid.Buyer.String()),
+					sdk.NewAttribute(types.AttributeKeyBundle, strings.Join(status.Bundle, ",")),
+					sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+					types.NewHeightAttribute(height),
+				),
+			)
+		}
+		return true
+	}
+
+	k.releaseDeposits(ctx, name, status, tried, height)
+	k.endUnsold(ctx, name, "no bid could be settled", height)
+	return false
+}
+
+// releaseDeposits refunds the deposits of the outbid bids of an ended auction
+// but the ones tried at its settlement
+func (k Keeper) releaseDeposits(ctx sdk.Context, name string, status types.SaleStatus, tried []bool, height int64) {
+	for i, bid := range status.Bids {
+		if !tried[i] && !bid.Deposit.IsZero() {
+			k.releaseDeposit(ctx, name, bid.Buyer, bid.Deposit, height)
+		}
+	}
+}
+
+// settlementFailed reports a bid of an auction that could not be settled
+func (k Keeper) settlementFailed(ctx sdk.Context, name string, whois types.Whois, bid types.Bid, err error, height int64) {
+	k.Logger(ctx).Error("failed to settle auction", "name", name, "buyer", bid.Buyer, "err", err)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionSettlementFailed,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeySeller, whois.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, bid.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, bid.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			types.NewHeightAttribute(height),
+		),
+	)
+}
+
+// penalizeDeposit burns the penalty out of the escrowed deposit of a bidder
+// whose winning bid failed to settle and refunds the rest. Should that fail
+// too, the deposit stays in escrow and the failure is reported.
+func (k Keeper) penalizeDeposit(ctx sdk.Context, name string, buyer sdk.AccAddress, deposit, penalty sdk.Coins, height int64) {
+	cacheCtx, write := ctx.CacheContext()
+	err := func() error {
+		if !penalty.IsZero() {
+			if err := k.supplyKeeper.BurnCoins(cacheCtx, types.ModuleName, penalty); err != nil {
+				return err
+			}
+		}
+		if rest := deposit.Sub(penalty); !rest.IsZero() {
+			return k.supplyKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, buyer, rest)
+		}
+		return nil
+	}()
+	if err != nil {
+		k.depositLeftInEscrow(ctx, name, buyer, deposit, err, height)
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBidDefaulted,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyBuyer, buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, penalty.String()),
+			types.NewHeightAttribute(height),
+		),
+	)
+}
+
+// releaseDeposit refunds the escrowed deposit of a bid. Should that fail, the
+// deposit stays in escrow and the failure is reported.
+func (k Keeper) releaseDeposit(ctx sdk.Context, name string, buyer sdk.AccAddress, deposit sdk.Coins, height int64) {
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, deposit); err != nil {
+		k.depositLeftInEscrow(ctx, name, buyer, deposit, err, height)
+	}
+}
+
+// depositLeftInEscrow reports a deposit that could not be released
+func (k Keeper) depositLeftInEscrow(ctx sdk.Context, name string, buyer sdk.AccAddress, deposit sdk.Coins, err error, height int64) {
+	k.Logger(ctx).Error("failed to release deposit", "name", name, "buyer", buyer, "err", err)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionSettlementFailed,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyBuyer, buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, "deposit left in escrow: "+err.Error()),
+			types.NewHeightAttribute(height),
+		),
+	)
+}
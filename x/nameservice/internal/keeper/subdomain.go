@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// IsSubdomain - returns whether a name has been issued under a parent name
+func (k Keeper) IsSubdomain(ctx sdk.Context, name string) bool {
+	return k.GetWhois(ctx, name).Parent != ""
+}
+
+// GetParentOwner - gets the owner of the parent name of a subdomain
+func (k Keeper) GetParentOwner(ctx sdk.Context, name string) sdk.AccAddress {
+	parent := k.GetWhois(ctx, name).Parent
+	if parent == "" {
+		return nil
+	}
+	return k.GetOwner(ctx, parent)
+}
+
+// CreateSubdomain - issues a subdomain under a parent name to its own owner
+func (k Keeper) CreateSubdomain(ctx sdk.Context, parent, label, value string, owner sdk.AccAddress) {
+	k.SetWhois(ctx, types.SubdomainName(label, parent), types.NewSubdomainWhois(parent, owner, value))
+}
+
+// GetSubdomainsIterator - gets an iterator over the labels of all subdomains
+// of a parent name
+func (k Keeper) GetSubdomainsIterator(ctx sdk.Context, parent string) sdk.Iterator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SubdomainsKey(parent))
+	return sdk.KVStorePrefixIterator(store, []byte{})
+}
+
+// GetSubdomains - gets the full names of all subdomains of a parent name
+func (k Keeper) GetSubdomains(ctx sdk.Context, parent string) []string {
+	var names []string
+	it := k.GetSubdomainsIterator(ctx, parent)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		names = append(names, types.SubdomainName(string(it.Key()), parent))
+	}
+	return names
+}
+
+// deleteSubdomains removes every subdomain of a parent name, recursively
+func (k Keeper) deleteSubdomains(ctx sdk.Context, parent string) {
+	for _, name := range k.GetSubdomains(ctx, parent) {
+		k.DeleteWhois(ctx, name)
+	}
+}
+
+// insertSubdomain indexes a subdomain under its parent name
+func (k Keeper) insertSubdomain(ctx sdk.Context, name string, whois types.Whois) {
+	if whois.Parent == "" {
+		return
+	}
+	label, _ := types.SplitSubdomain(name)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SubdomainKey(whois.Parent, label), []byte{})
+}
+
+// removeSubdomain removes a subdomain from the index of its parent name
+func (k Keeper) removeSubdomain(ctx sdk.Context, name string, whois types.Whois) {
+	if whois.Parent == "" {
+		return
+	}
+	label, _ := types.SplitSubdomain(name)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SubdomainKey(whois.Parent, label))
+}
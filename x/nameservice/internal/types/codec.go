@@ -20,4 +20,20 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBuyName{}, "nameservice/BuyName", nil)
 	cdc.RegisterConcrete(MsgDeleteName{}, "nameservice/DeleteName", nil)
 	cdc.RegisterConcrete(MsgSetSale{}, "nameservice/SetSale", nil)
+	cdc.RegisterConcrete(MsgRenewName{}, "nameservice/RenewName", nil)
+	cdc.RegisterConcrete(MsgCreateSubdomain{}, "nameservice/CreateSubdomain", nil)
+	cdc.RegisterConcrete(MsgUpdateSubdomain{}, "nameservice/UpdateSubdomain", nil)
+	cdc.RegisterConcrete(MsgRevokeSubdomain{}, "nameservice/RevokeSubdomain", nil)
+	cdc.RegisterConcrete(MsgSetRecord{}, "nameservice/SetRecord", nil)
+	cdc.RegisterConcrete(MsgClearRecord{}, "nameservice/ClearRecord", nil)
+	cdc.RegisterConcrete(MsgSetPrimaryName{}, "nameservice/SetPrimaryName", nil)
+	cdc.RegisterConcrete(MsgRevealBid{}, "nameservice/RevealBid", nil)
+	cdc.RegisterConcrete(MsgCancelSale{}, "nameservice/CancelSale", nil)
+	cdc.RegisterConcrete(MsgWithdrawBid{}, "nameservice/WithdrawBid", nil)
+	cdc.RegisterConcrete(MsgMakeOffer{}, "nameservice/MakeOffer", nil)
+	cdc.RegisterConcrete(MsgAcceptOffer{}, "nameservice/AcceptOffer", nil)
+	cdc.RegisterConcrete(MsgSwapNames{}, "nameservice/SwapNames", nil)
+	cdc.RegisterConcrete(MsgBuyBundle{}, "nameservice/BuyBundle", nil)
+	cdc.RegisterConcrete(ReserveNameProposal{}, "nameservice/ReserveNameProposal", nil)
+	cdc.RegisterConcrete(SeizeNameProposal{}, "nameservice/SeizeNameProposal", nil)
 }
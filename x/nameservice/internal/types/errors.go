@@ -6,4 +6,20 @@ import (
 
 var (
 	ErrNameDoesNotExist = sdkerrors.Register(ModuleName, 1, "name does not exist")
+	ErrNameExpired      = sdkerrors.Register(ModuleName, 2, "name has expired")
+	ErrNameExists       = sdkerrors.Register(ModuleName, 3, "name already exists")
+	ErrNotSubdomain     = sdkerrors.Register(ModuleName, 4, "name is not a subdomain")
+	ErrSubdomain        = sdkerrors.Register(ModuleName, 5, "name is a subdomain")
+	ErrInvalidRecord    = sdkerrors.Register(ModuleName, 6, "invalid record")
+	ErrRecordNotFound   = sdkerrors.Register(ModuleName, 7, "record not found")
+	ErrNotResolved      = sdkerrors.Register(ModuleName, 8, "name does not resolve to the address")
+	ErrValueTooLong     = sdkerrors.Register(ModuleName, 9, "value is too long")
+	ErrDenomNotAllowed  = sdkerrors.Register(ModuleName, 10, "denom is not allowed")
+	ErrNameReserved     = sdkerrors.Register(ModuleName, 11, "name is reserved")
+	ErrInvalidPhase     = sdkerrors.Register(ModuleName, 12, "sealed bid auction is not in this phase")
+	ErrBidNotFound      = sdkerrors.Register(ModuleName, 13, "bid not found")
+	ErrInvalidReveal    = sdkerrors.Register(ModuleName, 14, "revealed bid does not match its commitment")
+	ErrOfferNotFound    = sdkerrors.Register(ModuleName, 15, "offer not found")
+	ErrNameBundled      = sdkerrors.Register(ModuleName, 16, "name is sold in a bundle")
+	ErrOfferExpired     = sdkerrors.Register(ModuleName, 17, "offer has expired")
 )
@@ -1,15 +1,68 @@
 package types
 
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // nameservice module event types
 const (
-	// TODO: Create your event types
-	// EventType<Action>    		= "action"
+	EventTypeNameRegistered          = "name_registered"
+	EventTypeNameBought              = "name_bought"
+	EventTypeNameValueSet            = "name_value_set"
+	EventTypeNameDeleted             = "name_deleted"
+	EventTypeNameRenewed             = "name_renewed"
+	EventTypeNameReleased            = "name_released"
+	EventTypeNameReserved            = "name_reserved"
+	EventTypeNameSeized              = "name_seized"
+	EventTypeSaleListed              = "sale_listed"
+	EventTypeBidPlaced               = "bid_placed"
+	EventTypeBidRaised               = "bid_raised"
+	EventTypeBidCommitted            = "bid_committed"
+	EventTypeBidRevealed             = "bid_revealed"
+	EventTypeBidForfeited            = "bid_forfeited"
+	EventTypeBidDefaulted            = "bid_defaulted"
+	EventTypeBidWithdrawn            = "bid_withdrawn"
+	EventTypeSaleCancelled           = "sale_cancelled"
+	EventTypeListingExpired          = "listing_expired"
+	EventTypeNamesSwapped            = "names_swapped"
+	EventTypeBundleSold              = "bundle_sold"
+	EventTypeOfferMade               = "offer_made"
+	EventTypeOfferAccepted           = "offer_accepted"
+	EventTypeOfferExpired            = "offer_expired"
+	EventTypeAuctionSettled          = "auction_settled"
+	EventTypeAuctionSettlementFailed = "auction_settlement_failed"
+	EventTypeAuctionUnsold           = "auction_unsold"
+	EventTypeSubdomainCreated        = "subdomain_created"
+	EventTypeSubdomainUpdated        = "subdomain_updated"
+	EventTypeSubdomainRevoked        = "subdomain_revoked"
+	EventTypeRecordSet               = "record_set"
+	EventTypeRecordCleared           = "record_cleared"
+	EventTypePrimaryNameSet          = "primary_name_set"
 
-	// TODO: Create keys fo your events, the values will be derivided from the msg
-	// AttributeKeyAddress  		= "address"
-
-	// TODO: Some events may not have values for that reason you want to emit that something happened.
-	// AttributeValueDoubleSign = "double_sign"
+	AttributeKeyName         = "name"
+	AttributeKeyOwner        = "owner"
+	AttributeKeyBuyer        = "buyer"
+	AttributeKeySeller       = "seller"
+	AttributeKeyPrice        = "price"
+	AttributeKeyHeight       = "height"
+	AttributeKeyExpiry       = "expiry"
+	AttributeKeyEndHeight    = "end_height"
+	AttributeKeyValue        = "value"
+	AttributeKeySaleType     = "sale_type"
+	AttributeKeyParent       = "parent"
+	AttributeKeyRecordType   = "record_type"
+	AttributeKeyRecordKey    = "record_key"
+	AttributeKeyReason       = "reason"
+	AttributeKeyCounterName  = "counter_name"
+	AttributeKeyCounterOwner = "counter_owner"
+	AttributeKeyBundle       = "bundle"
 
 	AttributeValueCategory = ModuleName
 )
+
+// NewHeightAttribute returns the attribute holding the height of an event
+func NewHeightAttribute(height int64) sdk.Attribute {
+	return sdk.NewAttribute(AttributeKeyHeight, strconv.FormatInt(height, 10))
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "nameservice"
@@ -13,3 +20,190 @@ const (
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
 )
+
+// Keys for the nameservice store
+// Items are stored with the following key: values. This layout replaced the
+// unprefixed one of the first releases, see spec/02_state.md for the upgrade.
+//
+// - 0x01<name_Bytes>: Whois
+//
+// - 0x02<endHeight_Bytes><name_Bytes>: []byte{}
+//
+// - 0x03<expiry_Bytes><name_Bytes>: []byte{}
+//
+// - 0x04<name_Bytes>: releaseHeight
+//
+// - 0x05<releaseHeight_Bytes><name_Bytes>: []byte{}
+//
+// - 0x06<parent_Bytes>0x00<label_Bytes>: []byte{}
+//
+// - 0x07<accAddress_Bytes>: name
+//
+// - 0x08<owner_Bytes><name_Bytes>: []byte{}
+//
+// - 0x09<name_Bytes>: []byte{}
+//
+// - 0x0A<name_Bytes>0x00<buyer_Bytes>: Offer
+//
+// - 0x0B<expiryHeight_Bytes><name_Bytes>0x00<buyer_Bytes>: []byte{}
+//
+// - 0x0C<listingExpiry_Bytes><name_Bytes>: []byte{}
+//
+// - 0x0D<name_Bytes>: bundle
+var (
+	WhoisKeyPrefix        = []byte{0x01}
+	AuctionQueueKeyPrefix = []byte{0x02}
+	ExpiryQueueKeyPrefix  = []byte{0x03}
+	ReleaseKeyPrefix      = []byte{0x04}
+	PremiumQueueKeyPrefix = []byte{0x05}
+	SubdomainKeyPrefix    = []byte{0x06}
+	ReverseKeyPrefix      = []byte{0x07}
+	OwnerKeyPrefix        = []byte{0x08}
+	ReservedKeyPrefix     = []byte{0x09}
+	OfferKeyPrefix        = []byte{0x0A}
+	OfferQueueKeyPrefix   = []byte{0x0B}
+	ListingQueueKeyPrefix = []byte{0x0C}
+	BundleKeyPrefix       = []byte{0x0D}
+)
+
+// WhoisKey gets the key for the whois record of a name
+func WhoisKey(name string) []byte {
+	return append(WhoisKeyPrefix, []byte(name)...)
+}
+
+// AuctionQueueHeightKey gets the prefix of all auctions ending at a height
+func AuctionQueueHeightKey(endHeight int64) []byte {
+	return append(AuctionQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(endHeight))...)
+}
+
+// AuctionQueueKey gets the auction queue key of a name ending at a height
+func AuctionQueueKey(endHeight int64, name string) []byte {
+	return append(AuctionQueueHeightKey(endHeight), []byte(name)...)
+}
+
+// SplitAuctionQueueKey splits an auction queue key into its end height and name
+func SplitAuctionQueueKey(key []byte) (endHeight int64, name string) {
+	key = key[len(AuctionQueueKeyPrefix):]
+	return int64(binary.BigEndian.Uint64(key[:8])), string(key[8:])
+}
+
+// ExpiryQueueHeightKey gets the prefix of all names expiring at a height
+func ExpiryQueueHeightKey(expiry int64) []byte {
+	return append(ExpiryQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(expiry))...)
+}
+
+// ExpiryQueueKey gets the expiry queue key of a name expiring at a height
+func ExpiryQueueKey(expiry int64, name string) []byte {
+	return append(ExpiryQueueHeightKey(expiry), []byte(name)...)
+}
+
+// SplitExpiryQueueKey splits an expiry queue key into its expiry and name
+func SplitExpiryQueueKey(key []byte) (expiry int64, name string) {
+	key = key[len(ExpiryQueueKeyPrefix):]
+	return int64(binary.BigEndian.Uint64(key[:8])), string(key[8:])
+}
+
+// ReleaseKey gets the key for the release height of a name
+func ReleaseKey(name string) []byte {
+	return append(ReleaseKeyPrefix, []byte(name)...)
+}
+
+// PremiumQueueHeightKey gets the prefix of all names released at a height
+func PremiumQueueHeightKey(releaseHeight int64) []byte {
+	return append(PremiumQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(releaseHeight))...)
+}
+
+// PremiumQueueKey gets the premium queue key of a name released at a height
+func PremiumQueueKey(releaseHeight int64, name string) []byte {
+	return append(PremiumQueueHeightKey(releaseHeight), []byte(name)...)
+}
+
+// SplitPremiumQueueKey splits a premium queue key into its release height and name
+func SplitPremiumQueueKey(key []byte) (releaseHeight int64, name string) {
+	key = key[len(PremiumQueueKeyPrefix):]
+	return int64(binary.BigEndian.Uint64(key[:8])), string(key[8:])
+}
+
+// SubdomainsKey gets the prefix of all subdomains of a parent name
+func SubdomainsKey(parent string) []byte {
+	return append(append(SubdomainKeyPrefix, []byte(parent)...), 0x00)
+}
+
+// SubdomainKey gets the key of a subdomain label under a parent name
+func SubdomainKey(parent, label string) []byte {
+	return append(SubdomainsKey(parent), []byte(label)...)
+}
+
+// ReverseKey gets the key for the primary name of an address
+func ReverseKey(addr sdk.AccAddress) []byte {
+	return append(ReverseKeyPrefix, addr.Bytes()...)
+}
+
+// OwnerNamesKey gets the prefix of all names owned by an address
+func OwnerNamesKey(owner sdk.AccAddress) []byte {
+	return append(OwnerKeyPrefix, owner.Bytes()...)
+}
+
+// OwnerNameKey gets the owner index key of a name owned by an address
+func OwnerNameKey(owner sdk.AccAddress, name string) []byte {
+	return append(OwnerNamesKey(owner), []byte(name)...)
+}
+
+// ReservedKey gets the key of a reserved name
+func ReservedKey(name string) []byte {
+	return append(ReservedKeyPrefix, []byte(name)...)
+}
+
+// OffersKey gets the prefix of all offers on a name
+func OffersKey(name string) []byte {
+	return append(append(OfferKeyPrefix, []byte(name)...), 0x00)
+}
+
+// OfferKey gets the key of the offer of a buyer on a name
+func OfferKey(name string, buyer sdk.AccAddress) []byte {
+	return append(OffersKey(name), buyer.Bytes()...)
+}
+
+// OfferQueueHeightKey gets the prefix of all offers expiring at a height
+func OfferQueueHeightKey(expiryHeight int64) []byte {
+	return append(OfferQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+}
+
+// OfferQueueKey gets the offer queue key of the offer of a buyer on a name
+// expiring at a height
+func OfferQueueKey(expiryHeight int64, name string, buyer sdk.AccAddress) []byte {
+	return append(append(append(OfferQueueHeightKey(expiryHeight), []byte(name)...), 0x00), buyer.Bytes()...)
+}
+
+// SplitOfferQueueKey splits an offer queue key into its expiry height, name
+// and buyer
+func SplitOfferQueueKey(key []byte) (expiryHeight int64, name string, buyer sdk.AccAddress) {
+	key = key[len(OfferQueueKeyPrefix):]
+	expiryHeight = int64(binary.BigEndian.Uint64(key[:8]))
+	key = key[8:]
+	sep := bytes.IndexByte(key, 0x00)
+	return expiryHeight, string(key[:sep]), sdk.AccAddress(key[sep+1:])
+}
+
+// ListingQueueHeightKey gets the prefix of all listings expiring at a height
+func ListingQueueHeightKey(listingExpiry int64) []byte {
+	return append(ListingQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(listingExpiry))...)
+}
+
+// ListingQueueKey gets the listing queue key of a name whose listing expires
+// at a height
+func ListingQueueKey(listingExpiry int64, name string) []byte {
+	return append(ListingQueueHeightKey(listingExpiry), []byte(name)...)
+}
+
+// SplitListingQueueKey splits a listing queue key into its listing expiry
+// and name
+func SplitListingQueueKey(key []byte) (listingExpiry int64, name string) {
+	key = key[len(ListingQueueKeyPrefix):]
+	return int64(binary.BigEndian.Uint64(key[:8])), string(key[8:])
+}
+
+// BundleKey gets the key for the name whose bundle sale a name is part of
+func BundleKey(name string) []byte {
+	return append(BundleKeyPrefix, []byte(name)...)
+}
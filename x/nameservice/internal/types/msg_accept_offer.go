@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgAcceptOffer - struct for selling a name to the buyer of an offer
+type MsgAcceptOffer struct {
+	Name  string         `json:"name"`
+	Buyer sdk.AccAddress `json:"buyer"`
+	Owner sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgAcceptOffer creates a new MsgAcceptOffer instance
+func NewMsgAcceptOffer(name string, buyer, owner sdk.AccAddress) MsgAcceptOffer {
+	return MsgAcceptOffer{
+		Name:  name,
+		Buyer: buyer,
+		Owner: owner,
+	}
+}
+
+const AcceptOfferConst = "accept_offer"
+
+// nolint
+func (msg MsgAcceptOffer) Route() string { return RouterKey }
+func (msg MsgAcceptOffer) Type() string  { return AcceptOfferConst }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAcceptOffer) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.Buyer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Buyer.String())
+	}
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAcceptOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAcceptOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgBuyBundle - struct for buying a name sold in a bundle along with the
+// other names of the bundle, which the buyer lists so that it only pays for
+// the bundle it saw. On an open auction, Bid is a bid on the whole bundle.
+type MsgBuyBundle struct {
+	Name   string         `json:"name"`
+	Bundle []string       `json:"bundle"`
+	Bid    sdk.Coins      `json:"bid"`
+	Buyer  sdk.AccAddress `json:"buyer"`
+}
+
+// NewMsgBuyBundle creates a new MsgBuyBundle instance
+func NewMsgBuyBundle(name string, bundle []string, bid sdk.Coins, buyer sdk.AccAddress) MsgBuyBundle {
+	return MsgBuyBundle{
+		Name:   name,
+		Bundle: bundle,
+		Bid:    bid,
+		Buyer:  buyer,
+	}
+}
+
+const BuyBundleConst = "buy_bundle"
+
+// nolint
+func (msg MsgBuyBundle) Route() string { return RouterKey }
+func (msg MsgBuyBundle) Type() string  { return BuyBundleConst }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgBuyBundle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgBuyBundle) ValidateBasic() error {
+	if msg.Buyer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Buyer.String())
+	}
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	if len(msg.Bundle) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Bundle cannot be empty")
+	}
+	if !msg.Bid.IsAllPositive() {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	return ValidateBundle(msg.Name, msg.Bundle)
+}
+
+func (msg MsgBuyBundle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Buyer}
+}
@@ -5,11 +5,15 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// MsgBuyName - struct for unjailing jailed validator
+// MsgBuyName - struct for unjailing jailed validator. In a sealed bid
+// auction, Bid is the deposit escrowed along with the Commitment of the bid.
+// On an open auction, a MaxBid makes a proxy bid raised up to it when outbid.
 type MsgBuyName struct {
-	Name  string         `json:"name"`
-	Bid   sdk.Coins      `json:"bid"`
-	Buyer sdk.AccAddress `json:"buyer"`
+	Name       string         `json:"name"`
+	Bid        sdk.Coins      `json:"bid"`
+	Buyer      sdk.AccAddress `json:"buyer"`
+	Commitment string         `json:"commitment,omitempty"`
+	MaxBid     sdk.Coins      `json:"max_bid,omitempty"`
 }
 
 // NewMsgBuyName creates a new MsgBuyName instance
@@ -21,6 +25,27 @@ func NewMsgBuyName(name string, bid sdk.Coins, buyer sdk.AccAddress) MsgBuyName
 	}
 }
 
+// NewMsgCommitBid creates a new MsgBuyName instance committing a sealed bid
+func NewMsgCommitBid(name string, deposit sdk.Coins, commitment string, buyer sdk.AccAddress) MsgBuyName {
+	return MsgBuyName{
+		Name:       name,
+		Bid:        deposit,
+		Buyer:      buyer,
+		Commitment: commitment,
+	}
+}
+
+// NewMsgProxyBid creates a new MsgBuyName instance bidding on an open auction
+// up to a maximum price
+func NewMsgProxyBid(name string, bid, maxBid sdk.Coins, buyer sdk.AccAddress) MsgBuyName {
+	return MsgBuyName{
+		Name:   name,
+		Bid:    bid,
+		Buyer:  buyer,
+		MaxBid: maxBid,
+	}
+}
+
 const BuyNameConst = "buy_name"
 
 // nolint
@@ -40,9 +65,23 @@ func (msg MsgBuyName) ValidateBasic() error {
 	if len(msg.Name) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
 	}
+	if err := ValidateName(msg.Name); err != nil {
+		return err
+	}
 	if !msg.Bid.IsAllPositive() {
 		return sdkerrors.ErrInsufficientFunds
 	}
+	if !msg.MaxBid.Empty() {
+		if msg.Commitment != "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "A sealed bid cannot have a maximum bid")
+		}
+		if !msg.MaxBid.IsValid() || len(msg.MaxBid) != len(msg.Bid) || !msg.MaxBid.IsAllGTE(msg.Bid) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Maximum bid must be in the denoms of the bid and at least the bid")
+		}
+	}
+	if msg.Commitment != "" {
+		return ValidateCommitment(msg.Commitment)
+	}
 	return nil
 }
 
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgCancelSale - struct for taking a name off the market
+type MsgCancelSale struct {
+	Name  string         `json:"name"`
+	Owner sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgCancelSale creates a new MsgCancelSale instance
+func NewMsgCancelSale(name string, owner sdk.AccAddress) MsgCancelSale {
+	return MsgCancelSale{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+const CancelSaleConst = "cancel_sale"
+
+// nolint
+func (msg MsgCancelSale) Route() string { return RouterKey }
+func (msg MsgCancelSale) Type() string  { return CancelSaleConst }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelSale) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelSale) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelSale) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgClearRecord - struct for clearing a typed record of a name
+type MsgClearRecord struct {
+	Name       string         `json:"name"`
+	RecordType string         `json:"record_type"`
+	Key        string         `json:"key,omitempty"`
+	Owner      sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgClearRecord creates a new MsgClearRecord instance
+func NewMsgClearRecord(name, recordType, key string, owner sdk.AccAddress) MsgClearRecord {
+	return MsgClearRecord{
+		Name:       name,
+		RecordType: recordType,
+		Key:        key,
+		Owner:      owner,
+	}
+}
+
+const ClearRecordConst = "clear_record"
+
+// nolint
+func (msg MsgClearRecord) Route() string { return RouterKey }
+func (msg MsgClearRecord) Type() string  { return ClearRecordConst }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgClearRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgClearRecord) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	if err := ValidateRecordKey(msg.RecordType, msg.Key); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRecord, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgClearRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgCreateSubdomain - struct for issuing a subdomain under a parent name
+type MsgCreateSubdomain struct {
+	Parent   string         `json:"parent"`
+	Label    string         `json:"label"`
+	Value    string         `json:"value"`
+	SubOwner sdk.AccAddress `json:"sub_owner"`
+	Owner    sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgCreateSubdomain creates a new MsgCreateSubdomain instance
+func NewMsgCreateSubdomain(parent, label, value string, subOwner, owner sdk.AccAddress) MsgCreateSubdomain {
+	return MsgCreateSubdomain{
+		Parent:   parent,
+		Label:    label,
+		Value:    value,
+		SubOwner: subOwner,
+		Owner:    owner,
+	}
+}
+
+const CreateSubdomainConst = "create_subdomain"
+
+// nolint
+func (msg MsgCreateSubdomain) Route() string { return RouterKey }
+func (msg MsgCreateSubdomain) Type() string  { return CreateSubdomainConst }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgCreateSubdomain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgCreateSubdomain) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.SubOwner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.SubOwner.String())
+	}
+	if len(msg.Parent) == 0 || len(msg.Label) == 0 || len(msg.Value) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Parent, Label and/or Value cannot be empty")
+	}
+	if strings.Contains(msg.Label, SubdomainSeparator) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Label cannot contain the subdomain separator")
+	}
+	if err := ValidateName(msg.Label); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgCreateSubdomain) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
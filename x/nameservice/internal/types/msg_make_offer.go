@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgMakeOffer - struct for escrowing an offer on a name that is not for sale
+type MsgMakeOffer struct {
+	Name         string         `json:"name"`
+	Price        sdk.Coins      `json:"price"`
+	ExpiryHeight int64          `json:"expiry_height"`
+	Buyer        sdk.AccAddress `json:"buyer"`
+}
+
+// NewMsgMakeOffer creates a new MsgMakeOffer instance
+func NewMsgMakeOffer(name string, price sdk.Coins, expiryHeight int64, buyer sdk.AccAddress) MsgMakeOffer {
+	return MsgMakeOffer{
+		Name:         name,
+		Price:        price,
+		ExpiryHeight: expiryHeight,
+		Buyer:        buyer,
+	}
+}
+
+const MakeOfferConst = "make_offer"
+
+// nolint
+func (msg MsgMakeOffer) Route() string { return RouterKey }
+func (msg MsgMakeOffer) Type() string  { return MakeOfferConst }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMakeOffer) ValidateBasic() error {
+	if msg.Buyer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Buyer.String())
+	}
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	if err := ValidateName(msg.Name); err != nil {
+		return err
+	}
+	if !msg.Price.IsAllPositive() {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	if msg.ExpiryHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Expiry height must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMakeOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMakeOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Buyer}
+}
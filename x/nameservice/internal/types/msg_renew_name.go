@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgRenewName - struct for extending the registration of a name
+type MsgRenewName struct {
+	Name  string         `json:"name"`
+	Years int64          `json:"years"`
+	Owner sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgRenewName creates a new MsgRenewName instance
+func NewMsgRenewName(name string, years int64, owner sdk.AccAddress) MsgRenewName {
+	return MsgRenewName{
+		Name:  name,
+		Years: years,
+		Owner: owner,
+	}
+}
+
+const RenewNameConst = "renew_name"
+
+// MaxRenewalYears is the most years a name is renewed for at once, which keeps
+// the extended expiry and the rent charged for it from overflowing
+const MaxRenewalYears = 100
+
+// nolint
+func (msg MsgRenewName) Route() string { return RouterKey }
+func (msg MsgRenewName) Type() string  { return RenewNameConst }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRenewName) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgRenewName) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	if msg.Years <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Years must be positive")
+	}
+	if msg.Years > MaxRenewalYears {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Years must be at most %d", MaxRenewalYears)
+	}
+	return nil
+}
+
+func (msg MsgRenewName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgRevealBid - struct for revealing a sealed bid committed earlier
+type MsgRevealBid struct {
+	Name  string         `json:"name"`
+	Bid   sdk.Coins      `json:"bid"`
+	Salt  string         `json:"salt"`
+	Buyer sdk.AccAddress `json:"buyer"`
+}
+
+// NewMsgRevealBid creates a new MsgRevealBid instance
+func NewMsgRevealBid(name string, bid sdk.Coins, salt string, buyer sdk.AccAddress) MsgRevealBid {
+	return MsgRevealBid{
+		Name:  name,
+		Bid:   bid,
+		Salt:  salt,
+		Buyer: buyer,
+	}
+}
+
+const RevealBidConst = "reveal_bid"
+
+// nolint
+func (msg MsgRevealBid) Route() string { return RouterKey }
+func (msg MsgRevealBid) Type() string  { return RevealBidConst }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRevealBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgRevealBid) ValidateBasic() error {
+	if msg.Buyer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Buyer.String())
+	}
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	if !msg.Bid.IsAllPositive() {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	return nil
+}
+
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Buyer}
+}
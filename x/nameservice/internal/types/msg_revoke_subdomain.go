@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgRevokeSubdomain - struct for revoking a subdomain on behalf of the owner
+// of its parent name
+type MsgRevokeSubdomain struct {
+	Name  string         `json:"name"`
+	Owner sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgRevokeSubdomain creates a new MsgRevokeSubdomain instance
+func NewMsgRevokeSubdomain(name string, owner sdk.AccAddress) MsgRevokeSubdomain {
+	return MsgRevokeSubdomain{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+const RevokeSubdomainConst = "revoke_subdomain"
+
+// nolint
+func (msg MsgRevokeSubdomain) Route() string { return RouterKey }
+func (msg MsgRevokeSubdomain) Type() string  { return RevokeSubdomainConst }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRevokeSubdomain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgRevokeSubdomain) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if !IsSubdomain(msg.Name) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name must be a subdomain")
+	}
+	return nil
+}
+
+func (msg MsgRevokeSubdomain) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgSetPrimaryName - struct for picking the canonical name of an address
+type MsgSetPrimaryName struct {
+	Name  string         `json:"name"`
+	Owner sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgSetPrimaryName creates a new MsgSetPrimaryName instance
+func NewMsgSetPrimaryName(name string, owner sdk.AccAddress) MsgSetPrimaryName {
+	return MsgSetPrimaryName{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+const SetPrimaryNameConst = "set_primary_name"
+
+// nolint
+func (msg MsgSetPrimaryName) Route() string { return RouterKey }
+func (msg MsgSetPrimaryName) Type() string  { return SetPrimaryNameConst }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgSetPrimaryName) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgSetPrimaryName) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	return nil
+}
+
+func (msg MsgSetPrimaryName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
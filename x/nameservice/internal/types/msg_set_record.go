@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgSetRecord - struct for setting a typed record of a name
+type MsgSetRecord struct {
+	Name       string         `json:"name"`
+	RecordType string         `json:"record_type"`
+	Key        string         `json:"key,omitempty"`
+	Value      string         `json:"value"`
+	Owner      sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgSetRecord creates a new MsgSetRecord instance
+func NewMsgSetRecord(name, recordType, key, value string, owner sdk.AccAddress) MsgSetRecord {
+	return MsgSetRecord{
+		Name:       name,
+		RecordType: recordType,
+		Key:        key,
+		Value:      value,
+		Owner:      owner,
+	}
+}
+
+const SetRecordConst = "set_record"
+
+// nolint
+func (msg MsgSetRecord) Route() string { return RouterKey }
+func (msg MsgSetRecord) Type() string  { return SetRecordConst }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgSetRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgSetRecord) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	if err := ValidateRecord(msg.RecordType, msg.Key, msg.Value); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRecord, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgSetRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
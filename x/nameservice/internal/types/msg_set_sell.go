@@ -11,6 +11,18 @@ type MsgSetSale struct {
 	Name     string         `json:"name"`
 	SaleType SaleType       `json:"saleType"`
 	Price    sdk.Coins      `json:"price"`
+	// Dutch auctions fall from Price to FloorPrice over Duration blocks
+	FloorPrice sdk.Coins `json:"floor_price,omitempty"`
+	Duration   int64     `json:"duration,omitempty"`
+	// Auctions do not sell below ReservePrice, which HideReserve keeps out of queries
+	ReservePrice sdk.Coins `json:"reserve_price,omitempty"`
+	HideReserve  bool      `json:"hide_reserve,omitempty"`
+	// A normal sale with AllowedBuyers is a private sale only they can buy from
+	AllowedBuyers []sdk.AccAddress `json:"allowed_buyers,omitempty"`
+	// A normal sale with a ListingExpiry goes off the market after that height
+	ListingExpiry int64 `json:"listing_expiry,omitempty"`
+	// A normal sale or an open auction with a Bundle sells those names along with Name
+	Bundle []string `json:"bundle,omitempty"`
 }
 
 // NewMsgSetSale creates a new MsgSetSale instance
@@ -23,6 +35,55 @@ func NewMsgSetSale(owner sdk.AccAddress, name string, saleType SaleType, price s
 	}
 }
 
+// NewMsgSetDutchSale creates a new MsgSetSale instance listing a dutch auction
+func NewMsgSetDutchSale(owner sdk.AccAddress, name string, startPrice, floorPrice sdk.Coins, duration int64) MsgSetSale {
+	return MsgSetSale{
+		Owner:      owner,
+		Name:       name,
+		SaleType:   SaleTypeDutchAuction,
+		Price:      startPrice,
+		FloorPrice: floorPrice,
+		Duration:   duration,
+	}
+}
+
+// NewMsgSetAuctionSale creates a new MsgSetSale instance listing an auction
+// with a reserve price
+func NewMsgSetAuctionSale(owner sdk.AccAddress, name string, saleType SaleType, price, reservePrice sdk.Coins, hideReserve bool) MsgSetSale {
+	return MsgSetSale{
+		Owner:        owner,
+		Name:         name,
+		SaleType:     saleType,
+		Price:        price,
+		ReservePrice: reservePrice,
+		HideReserve:  hideReserve,
+	}
+}
+
+// NewMsgSetPrivateSale creates a new MsgSetSale instance listing a normal sale
+// to the allowed buyers only
+func NewMsgSetPrivateSale(owner sdk.AccAddress, name string, price sdk.Coins, allowedBuyers []sdk.AccAddress) MsgSetSale {
+	return MsgSetSale{
+		Owner:         owner,
+		Name:          name,
+		SaleType:      SaleTypeNormal,
+		Price:         price,
+		AllowedBuyers: allowedBuyers,
+	}
+}
+
+// NewMsgSetBundleSale creates a new MsgSetSale instance listing a name along
+// with other names as one bundle
+func NewMsgSetBundleSale(owner sdk.AccAddress, name string, saleType SaleType, price sdk.Coins, bundle []string) MsgSetSale {
+	return MsgSetSale{
+		Owner:    owner,
+		Name:     name,
+		SaleType: saleType,
+		Price:    price,
+		Bundle:   bundle,
+	}
+}
+
 const SetSaleConst = "set_sell"
 
 // nolint
@@ -44,6 +105,100 @@ func (msg MsgSetSale) ValidateBasic() error {
 		msg.Price.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Illegal parameters")
 	}
+	if msg.SaleType == SaleTypeAuction || msg.SaleType == SaleTypeSecondPriceAuction {
+		if !msg.ReservePrice.IsValid() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid reserve price")
+		}
+		if msg.HideReserve && msg.ReservePrice.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Only a reserve price can be hidden")
+		}
+	} else if !msg.ReservePrice.Empty() || msg.HideReserve {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Reserve price only applies to an auction")
+	}
+	if len(msg.AllowedBuyers) > 0 {
+		if msg.SaleType != SaleTypeNormal {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Allowed buyers only apply to a normal sale")
+		}
+		if err := ValidateAllowedBuyers(msg.AllowedBuyers); err != nil {
+			return err
+		}
+	}
+	if msg.ListingExpiry < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Listing expiry cannot be negative")
+	}
+	if msg.ListingExpiry > 0 && msg.SaleType != SaleTypeNormal {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Listing expiry only applies to a normal sale")
+	}
+	if len(msg.Bundle) > 0 {
+		switch msg.SaleType {
+		case SaleTypeNormal, SaleTypeAuction, SaleTypeSecondPriceAuction:
+		default:
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "A bundle only applies to a normal sale or an open auction")
+		}
+		if err := ValidateBundle(msg.Name, msg.Bundle); err != nil {
+			return err
+		}
+	}
+	if msg.SaleType != SaleTypeDutchAuction {
+		if !msg.FloorPrice.Empty() || msg.Duration != 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Floor price and duration only apply to a dutch auction")
+		}
+		return nil
+	}
+	if !msg.FloorPrice.IsValid() || msg.FloorPrice.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Floor price must be positive")
+	}
+	if !msg.Price.IsAllGTE(msg.FloorPrice) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Start price must not be below the floor price")
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Duration must be positive")
+	}
+	return nil
+}
+
+// MaxAllowedBuyers is the most buyers a private sale is restricted to
+const MaxAllowedBuyers = 10
+
+// ValidateAllowedBuyers checks the buyers a private sale is restricted to
+func ValidateAllowedBuyers(buyers []sdk.AccAddress) error {
+	if len(buyers) > MaxAllowedBuyers {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "A private sale has at most %d allowed buyers", MaxAllowedBuyers)
+	}
+	for i, buyer := range buyers {
+		if buyer.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Allowed buyer cannot be empty")
+		}
+		for _, other := range buyers[:i] {
+			if buyer.Equals(other) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Duplicate allowed buyer %s", buyer)
+			}
+		}
+	}
+	return nil
+}
+
+// MaxBundleNames is the most names sold along with a name in a bundle
+const MaxBundleNames = 10
+
+// ValidateBundle checks the other names sold along with a name in a bundle
+func ValidateBundle(name string, bundle []string) error {
+	if len(bundle) > MaxBundleNames {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "A bundle has at most %d other names", MaxBundleNames)
+	}
+	for i, member := range bundle {
+		if len(member) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Bundled name cannot be empty")
+		}
+		if member == name {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s cannot be bundled with itself", name)
+		}
+		for _, other := range bundle[:i] {
+			if member == other {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Duplicate bundled name %s", member)
+			}
+		}
+	}
 	return nil
 }
 
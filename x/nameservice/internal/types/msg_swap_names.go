@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgSwapNames - struct for exchanging two names between their owners, with
+// a Payment from the owner of Name to the owner of CounterName balancing the
+// trade. Both owners sign it.
+type MsgSwapNames struct {
+	Name         string         `json:"name"`
+	Owner        sdk.AccAddress `json:"owner"`
+	CounterName  string         `json:"counter_name"`
+	CounterOwner sdk.AccAddress `json:"counter_owner"`
+	Payment      sdk.Coins      `json:"payment,omitempty"`
+}
+
+// NewMsgSwapNames creates a new MsgSwapNames instance
+func NewMsgSwapNames(name string, owner sdk.AccAddress, counterName string, counterOwner sdk.AccAddress, payment sdk.Coins) MsgSwapNames {
+	return MsgSwapNames{
+		Name:         name,
+		Owner:        owner,
+		CounterName:  counterName,
+		CounterOwner: counterOwner,
+		Payment:      payment,
+	}
+}
+
+const SwapNamesConst = "swap_names"
+
+// nolint
+func (msg MsgSwapNames) Route() string { return RouterKey }
+func (msg MsgSwapNames) Type() string  { return SwapNamesConst }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSwapNames) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.CounterOwner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CounterOwner.String())
+	}
+	if msg.Owner.Equals(msg.CounterOwner) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Names are swapped between two different owners")
+	}
+	if len(msg.Name) == 0 || len(msg.CounterName) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	if msg.Name == msg.CounterName {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "A name cannot be swapped with itself")
+	}
+	if !msg.Payment.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Payment.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSwapNames) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signatures are required, the owners of both names
+func (msg MsgSwapNames) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner, msg.CounterOwner}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgUpdateSubdomain - struct for changing the owner and value of a subdomain
+// on behalf of the owner of its parent name
+type MsgUpdateSubdomain struct {
+	Name     string         `json:"name"`
+	Value    string         `json:"value"`
+	SubOwner sdk.AccAddress `json:"sub_owner"`
+	Owner    sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgUpdateSubdomain creates a new MsgUpdateSubdomain instance
+func NewMsgUpdateSubdomain(name, value string, subOwner, owner sdk.AccAddress) MsgUpdateSubdomain {
+	return MsgUpdateSubdomain{
+		Name:     name,
+		Value:    value,
+		SubOwner: subOwner,
+		Owner:    owner,
+	}
+}
+
+const UpdateSubdomainConst = "update_subdomain"
+
+// nolint
+func (msg MsgUpdateSubdomain) Route() string { return RouterKey }
+func (msg MsgUpdateSubdomain) Type() string  { return UpdateSubdomainConst }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgUpdateSubdomain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgUpdateSubdomain) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.SubOwner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.SubOwner.String())
+	}
+	if !IsSubdomain(msg.Name) || len(msg.Value) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name must be a subdomain and Value cannot be empty")
+	}
+	return nil
+}
+
+func (msg MsgUpdateSubdomain) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
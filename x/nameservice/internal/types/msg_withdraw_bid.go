@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgWithdrawBid - struct for withdrawing the outbid bids of a buyer from an auction
+type MsgWithdrawBid struct {
+	Name  string         `json:"name"`
+	Buyer sdk.AccAddress `json:"buyer"`
+}
+
+// NewMsgWithdrawBid creates a new MsgWithdrawBid instance
+func NewMsgWithdrawBid(name string, buyer sdk.AccAddress) MsgWithdrawBid {
+	return MsgWithdrawBid{
+		Name:  name,
+		Buyer: buyer,
+	}
+}
+
+const WithdrawBidConst = "withdraw_bid"
+
+// nolint
+func (msg MsgWithdrawBid) Route() string { return RouterKey }
+func (msg MsgWithdrawBid) Type() string  { return WithdrawBidConst }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawBid) ValidateBasic() error {
+	if msg.Buyer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Buyer.String())
+	}
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgWithdrawBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdrawBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Buyer}
+}
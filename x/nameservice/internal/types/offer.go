@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Offer - coins a buyer escrows for a name that is not for sale, which its
+// owner can accept before the expiry height. The offer is refunded at it.
+type Offer struct {
+	Name         string         `json:"name"`
+	Buyer        sdk.AccAddress `json:"buyer"`
+	Price        sdk.Coins      `json:"price"`
+	ExpiryHeight int64          `json:"expiry_height"`
+}
+
+// NewOffer returns a new Offer
+func NewOffer(name string, buyer sdk.AccAddress, price sdk.Coins, expiryHeight int64) Offer {
+	return Offer{
+		Name:         name,
+		Buyer:        buyer,
+		Price:        price,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// IsExpired returns whether the offer can no longer be accepted at the given
+// height, being due for a refund
+func (o Offer) IsExpired(height int64) bool {
+	return o.ExpiryHeight <= height
+}
+
+// implement fmt.Stringer
+func (o Offer) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Name: %s
+Buyer: %s
+Price: %s
+Expiry Height: %d`, o.Name, o.Buyer, o.Price, o.ExpiryHeight))
+}
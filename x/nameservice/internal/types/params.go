@@ -2,20 +2,77 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Default parameter namespace
 const (
 	DefaultParamspace = ModuleName
-	// TODO: Define your default parameters
+
+	// DefaultBlocksPerYear assumes 5 second block times
+	DefaultBlocksPerYear int64 = 60 * 60 * 8766 / 5
+	// DefaultRegistrationDuration registers a name for one year
+	DefaultRegistrationDuration = DefaultBlocksPerYear
+	// DefaultGracePeriod lets the prior owner renew for 30 days after expiry
+	DefaultGracePeriod int64 = 60 * 60 * 24 * 30 / 5
+	// DefaultPremiumPeriod decays the premium over 28 days after release
+	DefaultPremiumPeriod int64 = 60 * 60 * 24 * 28 / 5
+	// DefaultAuctionInterval lets an auction take bids for 100 blocks after its listing
+	DefaultAuctionInterval int64 = 100
+	// DefaultExtensionWindow extends an auction on bids in its last 10 blocks
+	DefaultExtensionWindow int64 = 10
+	// DefaultExtensionBlocks pushes the end of an auction out by 10 blocks
+	DefaultExtensionBlocks int64 = 10
+	// DefaultMaxExtensions extends an auction at most 10 times
+	DefaultMaxExtensions int64 = 10
+	// DefaultMaxValueLength bounds the value a name resolves to
+	DefaultMaxValueLength int64 = 256
+	// DefaultCommitPeriod lets sealed bids be committed for 3 days
+	DefaultCommitPeriod int64 = 60 * 60 * 24 * 3 / 5
+	// DefaultRevealPeriod lets sealed bids be revealed for 2 days
+	DefaultRevealPeriod int64 = 60 * 60 * 24 * 2 / 5
+)
+
+var (
+	// DefaultYearlyRent is the default fee to renew a name for one year
+	DefaultYearlyRent = sdk.Coins{sdk.NewInt64Coin("nametoken", 1)}
+	// DefaultPremiumStartPrice is the default price of a name right after release
+	DefaultPremiumStartPrice = sdk.Coins{sdk.NewInt64Coin("nametoken", 1000)}
+	// DefaultMinNamePrice is the default price of a name that was never previously owned
+	DefaultMinNamePrice = sdk.Coins{sdk.NewInt64Coin("nametoken", 1)}
+	// DefaultBidIncrement only requires a new bid to be higher than the last one
+	DefaultBidIncrement = sdk.ZeroDec()
+	// DefaultDefaultPenalty burns a tenth of the escrow of a bidder whose winning bid fails to settle
+	DefaultDefaultPenalty = sdk.NewDecWithPrec(1, 1)
+	// DefaultCancelPenalty burns a twentieth of the highest bid when a seller cancels an auction with bids
+	DefaultCancelPenalty = sdk.NewDecWithPrec(5, 2)
+	// DefaultAllowedDenoms only accepts nametoken for prices and bids
+	DefaultAllowedDenoms = []string{"nametoken"}
 )
 
 // Parameter store keys
 var (
-	// TODO: Define your keys for the parameter store
-	// KeyParamName          = []byte("ParamName")
+	KeyRegistrationDuration = []byte("RegistrationDuration")
+	KeyYearlyRent           = []byte("YearlyRent")
+	KeyBlocksPerYear        = []byte("BlocksPerYear")
+	KeyGracePeriod          = []byte("GracePeriod")
+	KeyPremiumPeriod        = []byte("PremiumPeriod")
+	KeyPremiumStartPrice    = []byte("PremiumStartPrice")
+	KeyMinNamePrice         = []byte("MinNamePrice")
+	KeyAuctionInterval      = []byte("AuctionInterval")
+	KeyBidIncrement         = []byte("BidIncrement")
+	KeyAllowedDenoms        = []byte("AllowedDenoms")
+	KeyMaxValueLength       = []byte("MaxValueLength")
+	KeyCommitPeriod         = []byte("CommitPeriod")
+	KeyRevealPeriod         = []byte("RevealPeriod")
+	KeyExtensionWindow      = []byte("ExtensionWindow")
+	KeyExtensionBlocks      = []byte("ExtensionBlocks")
+	KeyMaxExtensions        = []byte("MaxExtensions")
+	KeyDefaultPenalty       = []byte("DefaultPenalty")
+	KeyCancelPenalty        = []byte("CancelPenalty")
 )
 
 // ParamKeyTable for nameservice module
@@ -25,33 +82,324 @@ func ParamKeyTable() params.KeyTable {
 
 // Params - used for initializing default parameter for nameservice at genesis
 type Params struct {
-	// TODO: Add your Paramaters to the Paramter struct
-	// KeyParamName string `json:"key_param_name"`
+	RegistrationDuration int64     `json:"registration_duration" yaml:"registration_duration"` // blocks a newly registered name is owned for
+	YearlyRent           sdk.Coins `json:"yearly_rent" yaml:"yearly_rent"`                     // fee to renew a name for one year
+	BlocksPerYear        int64     `json:"blocks_per_year" yaml:"blocks_per_year"`             // expected blocks per year
+	GracePeriod          int64     `json:"grace_period" yaml:"grace_period"`                   // blocks after expiry during which only the prior owner may renew
+	PremiumPeriod        int64     `json:"premium_period" yaml:"premium_period"`               // blocks over which the premium of a released name decays
+	PremiumStartPrice    sdk.Coins `json:"premium_start_price" yaml:"premium_start_price"`     // price of a name right after its release
+	MinNamePrice         sdk.Coins `json:"min_name_price" yaml:"min_name_price"`               // price of a name that was never previously owned
+	AuctionInterval      int64     `json:"auction_interval" yaml:"auction_interval"`           // blocks an auction takes bids for after its listing
+	BidIncrement         sdk.Dec   `json:"bid_increment" yaml:"bid_increment"`                 // minimum raise of a bid over the last one, as a fraction of it
+	AllowedDenoms        []string  `json:"allowed_denoms" yaml:"allowed_denoms"`               // denoms accepted for prices and bids
+	MaxValueLength       int64     `json:"max_value_length" yaml:"max_value_length"`           // maximum length of the value of a name
+	CommitPeriod         int64     `json:"commit_period" yaml:"commit_period"`                 // blocks during which sealed bids are committed
+	RevealPeriod         int64     `json:"reveal_period" yaml:"reveal_period"`                 // blocks during which sealed bids are revealed
+	ExtensionWindow      int64     `json:"extension_window" yaml:"extension_window"`           // last blocks of an auction in which a bid extends it, 0 to never extend
+	ExtensionBlocks      int64     `json:"extension_blocks" yaml:"extension_blocks"`           // blocks a bid in the extension window pushes the end of an auction out by
+	MaxExtensions        int64     `json:"max_extensions" yaml:"max_extensions"`               // maximum number of times an auction is extended
+	DefaultPenalty       sdk.Dec   `json:"default_penalty" yaml:"default_penalty"`             // fraction of its escrow burned when a winning bid fails to settle
+	CancelPenalty        sdk.Dec   `json:"cancel_penalty" yaml:"cancel_penalty"`               // fraction of the highest bid a seller pays to cancel an auction with bids
 }
 
 // NewParams creates a new Params object
-func NewParams(/* TODO: Pass in the paramters*/) Params {
+func NewParams(
+	registrationDuration int64, yearlyRent sdk.Coins, blocksPerYear int64,
+	gracePeriod, premiumPeriod int64, premiumStartPrice, minNamePrice sdk.Coins,
+	auctionInterval int64, bidIncrement sdk.Dec, allowedDenoms []string, maxValueLength int64,
+	commitPeriod, revealPeriod, extensionWindow, extensionBlocks, maxExtensions int64,
+	defaultPenalty, cancelPenalty sdk.Dec,
+) Params {
+
 	return Params{
-		// TODO: Create your Params Type
+		RegistrationDuration: registrationDuration,
+		YearlyRent:           yearlyRent,
+		BlocksPerYear:        blocksPerYear,
+		GracePeriod:          gracePeriod,
+		PremiumPeriod:        premiumPeriod,
+		PremiumStartPrice:    premiumStartPrice,
+		MinNamePrice:         minNamePrice,
+		AuctionInterval:      auctionInterval,
+		BidIncrement:         bidIncrement,
+		AllowedDenoms:        allowedDenoms,
+		MaxValueLength:       maxValueLength,
+		CommitPeriod:         commitPeriod,
+		RevealPeriod:         revealPeriod,
+		ExtensionWindow:      extensionWindow,
+		ExtensionBlocks:      extensionBlocks,
+		MaxExtensions:        maxExtensions,
+		DefaultPenalty:       defaultPenalty,
+		CancelPenalty:        cancelPenalty,
 	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
-	return fmt.Sprintf(`
-	// TODO: Return all the params as a string
-	`, )
+	return fmt.Sprintf(`Params:
+  Registration Duration: %d
+  Yearly Rent:           %s
+  Blocks Per Year:       %d
+  Grace Period:          %d
+  Premium Period:        %d
+  Premium Start Price:   %s
+  Min Name Price:        %s
+  Auction Interval:      %d
+  Bid Increment:         %s
+  Allowed Denoms:        %s
+  Max Value Length:      %d
+  Commit Period:         %d
+  Reveal Period:         %d
+  Extension Window:      %d
+  Extension Blocks:      %d
+  Max Extensions:        %d
+  Default Penalty:       %s
+  Cancel Penalty:        %s`,
+		p.RegistrationDuration, p.YearlyRent, p.BlocksPerYear,
+		p.GracePeriod, p.PremiumPeriod, p.PremiumStartPrice, p.MinNamePrice,
+		p.AuctionInterval, p.BidIncrement, strings.Join(p.AllowedDenoms, ", "), p.MaxValueLength,
+		p.CommitPeriod, p.RevealPeriod, p.ExtensionWindow, p.ExtensionBlocks, p.MaxExtensions,
+		p.DefaultPenalty, p.CancelPenalty)
 }
 
 // ParamSetPairs - Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		// TODO: Pair your key with the param
-		// params.NewParamSetPair(KeyParamName, &p.ParamName),
+		params.NewParamSetPair(KeyRegistrationDuration, &p.RegistrationDuration, validatePositiveBlocks),
+		params.NewParamSetPair(KeyYearlyRent, &p.YearlyRent, validateCoins),
+		params.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validatePositiveBlocks),
+		params.NewParamSetPair(KeyGracePeriod, &p.GracePeriod, validateNonNegativeBlocks),
+		params.NewParamSetPair(KeyPremiumPeriod, &p.PremiumPeriod, validateNonNegativeBlocks),
+		params.NewParamSetPair(KeyPremiumStartPrice, &p.PremiumStartPrice, validateCoins),
+		params.NewParamSetPair(KeyMinNamePrice, &p.MinNamePrice, validateCoins),
+		params.NewParamSetPair(KeyAuctionInterval, &p.AuctionInterval, validatePositiveBlocks),
+		params.NewParamSetPair(KeyBidIncrement, &p.BidIncrement, validateBidIncrement),
+		params.NewParamSetPair(KeyAllowedDenoms, &p.AllowedDenoms, validateAllowedDenoms),
+		params.NewParamSetPair(KeyMaxValueLength, &p.MaxValueLength, validateMaxValueLength),
+		params.NewParamSetPair(KeyCommitPeriod, &p.CommitPeriod, validatePositiveBlocks),
+		params.NewParamSetPair(KeyRevealPeriod, &p.RevealPeriod, validatePositiveBlocks),
+		params.NewParamSetPair(KeyExtensionWindow, &p.ExtensionWindow, validateNonNegativeBlocks),
+		params.NewParamSetPair(KeyExtensionBlocks, &p.ExtensionBlocks, validateNonNegativeBlocks),
+		params.NewParamSetPair(KeyMaxExtensions, &p.MaxExtensions, validateMaxExtensions),
+		params.NewParamSetPair(KeyDefaultPenalty, &p.DefaultPenalty, validatePenalty),
+		params.NewParamSetPair(KeyCancelPenalty, &p.CancelPenalty, validatePenalty),
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams( /* TODO: Pass in your default Params */ )
+	return NewParams(
+		DefaultRegistrationDuration, DefaultYearlyRent, DefaultBlocksPerYear,
+		DefaultGracePeriod, DefaultPremiumPeriod, DefaultPremiumStartPrice, DefaultMinNamePrice,
+		DefaultAuctionInterval, DefaultBidIncrement, DefaultAllowedDenoms, DefaultMaxValueLength,
+		DefaultCommitPeriod, DefaultRevealPeriod, DefaultExtensionWindow, DefaultExtensionBlocks, DefaultMaxExtensions,
+		DefaultDefaultPenalty, DefaultCancelPenalty,
+	)
+}
+
+// Validate checks that the parameters have valid values
+func (p Params) Validate() error {
+	if err := validatePositiveBlocks(p.RegistrationDuration); err != nil {
+		return err
+	}
+	if err := validateCoins(p.YearlyRent); err != nil {
+		return err
+	}
+	if err := validatePositiveBlocks(p.BlocksPerYear); err != nil {
+		return err
+	}
+	if err := validateNonNegativeBlocks(p.GracePeriod); err != nil {
+		return err
+	}
+	if err := validateNonNegativeBlocks(p.PremiumPeriod); err != nil {
+		return err
+	}
+	if err := validateCoins(p.PremiumStartPrice); err != nil {
+		return err
+	}
+	if err := validateCoins(p.MinNamePrice); err != nil {
+		return err
+	}
+	if err := validatePositiveBlocks(p.AuctionInterval); err != nil {
+		return err
+	}
+	if err := validateBidIncrement(p.BidIncrement); err != nil {
+		return err
+	}
+	if err := validateAllowedDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := validateMaxValueLength(p.MaxValueLength); err != nil {
+		return err
+	}
+	if err := validatePositiveBlocks(p.CommitPeriod); err != nil {
+		return err
+	}
+	if err := validatePositiveBlocks(p.RevealPeriod); err != nil {
+		return err
+	}
+	if err := validateNonNegativeBlocks(p.ExtensionWindow); err != nil {
+		return err
+	}
+	if err := validateNonNegativeBlocks(p.ExtensionBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxExtensions(p.MaxExtensions); err != nil {
+		return err
+	}
+	if err := validatePenalty(p.DefaultPenalty); err != nil {
+		return err
+	}
+	return validatePenalty(p.CancelPenalty)
+}
+
+func validatePositiveBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("number of blocks must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateNonNegativeBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("number of blocks cannot be negative: %d", v)
+	}
+	return nil
+}
+
+func validateCoins(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() {
+		return fmt.Errorf("invalid coins: %s", v)
+	}
+	return nil
+}
+
+func validateBidIncrement(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("bid increment cannot be negative: %s", v)
+	}
+	return nil
+}
+
+func validateAllowedDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(v) == 0 {
+		return fmt.Errorf("allowed denoms cannot be empty")
+	}
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateMaxValueLength(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("max value length must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateMaxExtensions(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("max extensions cannot be negative: %d", v)
+	}
+	return nil
+}
+
+func validatePenalty(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("penalty must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+// IsDenomAllowed returns whether every denom of the coins is accepted for
+// prices and bids
+func (p Params) IsDenomAllowed(coins sdk.Coins) bool {
+	for _, coin := range coins {
+		allowed := false
+		for _, denom := range p.AllowedDenoms {
+			if coin.Denom == denom {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// MinNextBid returns the lowest amounts a new bid must reach to outbid the
+// given one, raising it by the bid increment rounded up
+func (p Params) MinNextBid(last sdk.Coins) sdk.Coins {
+	minBid := sdk.NewCoins()
+	for _, coin := range last {
+		raise := p.BidIncrement.MulInt(coin.Amount).Ceil().TruncateInt()
+		minBid = minBid.Add(sdk.NewCoin(coin.Denom, coin.Amount.Add(raise)))
+	}
+	return minBid
+}
+
+// DefaultPenaltyOf returns the part of an escrow burned when the winning bid
+// it backs fails to settle, rounded down. An outbid open bid keeps that part
+// of itself in escrow until the auction ends, should it fall back to it.
+func (p Params) DefaultPenaltyOf(deposit sdk.Coins) sdk.Coins {
+	return penaltyOf(p.DefaultPenalty, deposit)
+}
+
+// CancelPenaltyOf returns the penalty a seller pays to cancel an auction whose
+// highest bid is the given one, rounded down
+func (p Params) CancelPenaltyOf(bid sdk.Coins) sdk.Coins {
+	return penaltyOf(p.CancelPenalty, bid)
+}
+
+func penaltyOf(rate sdk.Dec, coins sdk.Coins) sdk.Coins {
+	penalty := sdk.NewCoins()
+	for _, coin := range coins {
+		penalty = penalty.Add(sdk.NewCoin(coin.Denom, rate.MulInt(coin.Amount).TruncateInt()))
+	}
+	return penalty
+}
+
+// RenewalFee returns the rent owed to renew a name for a number of years
+func (p Params) RenewalFee(years int64) sdk.Coins {
+	fee := sdk.NewCoins()
+	for _, coin := range p.YearlyRent {
+		fee = fee.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(years)))
+	}
+	return fee
 }
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeReserveName defines the type for a ReserveNameProposal
+	ProposalTypeReserveName = "ReserveName"
+	// ProposalTypeSeizeName defines the type for a SeizeNameProposal
+	ProposalTypeSeizeName = "SeizeName"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = ReserveNameProposal{}
+	_ govtypes.Content = SeizeNameProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeReserveName)
+	govtypes.RegisterProposalTypeCodec(ReserveNameProposal{}, "nameservice/ReserveNameProposal")
+	govtypes.RegisterProposalType(ProposalTypeSeizeName)
+	govtypes.RegisterProposalTypeCodec(SeizeNameProposal{}, "nameservice/SeizeNameProposal")
+}
+
+// ReserveNameProposal reserves names so that they can no longer be registered
+// by buying them. A name that is already registered keeps its owner until it
+// is released.
+type ReserveNameProposal struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Names       []string `json:"names" yaml:"names"`
+}
+
+// NewReserveNameProposal creates a new ReserveNameProposal instance
+func NewReserveNameProposal(title, description string, names []string) ReserveNameProposal {
+	return ReserveNameProposal{
+		Title:       title,
+		Description: description,
+		Names:       names,
+	}
+}
+
+// nolint
+func (p ReserveNameProposal) GetTitle() string       { return p.Title }
+func (p ReserveNameProposal) GetDescription() string { return p.Description }
+func (p ReserveNameProposal) ProposalRoute() string  { return RouterKey }
+func (p ReserveNameProposal) ProposalType() string   { return ProposalTypeReserveName }
+
+// ValidateBasic validates the reserve name proposal
+func (p ReserveNameProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Names) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Names cannot be empty")
+	}
+	for _, name := range p.Names {
+		if len(name) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+		}
+		if err := ValidateName(name); err != nil {
+			return err
+		}
+		if IsSubdomain(name) {
+			return sdkerrors.Wrap(ErrSubdomain, name)
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p ReserveNameProposal) String() string {
+	return fmt.Sprintf(`Reserve Name Proposal:
+  Title:       %s
+  Description: %s
+  Names:       %s
+`, p.Title, p.Description, strings.Join(p.Names, ", "))
+}
+
+// SeizeNameProposal hands a disputed name over to a new owner, cancelling
+// its sale and clearing what it resolves to. A reserved or unregistered name
+// is registered to the new owner for a new term.
+type SeizeNameProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Name        string         `json:"name" yaml:"name"`
+	NewOwner    sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+// NewSeizeNameProposal creates a new SeizeNameProposal instance
+func NewSeizeNameProposal(title, description, name string, newOwner sdk.AccAddress) SeizeNameProposal {
+	return SeizeNameProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+		NewOwner:    newOwner,
+	}
+}
+
+// nolint
+func (p SeizeNameProposal) GetTitle() string       { return p.Title }
+func (p SeizeNameProposal) GetDescription() string { return p.Description }
+func (p SeizeNameProposal) ProposalRoute() string  { return RouterKey }
+func (p SeizeNameProposal) ProposalType() string   { return ProposalTypeSeizeName }
+
+// ValidateBasic validates the seize name proposal
+func (p SeizeNameProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.NewOwner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.NewOwner.String())
+	}
+	if len(p.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+	if err := ValidateName(p.Name); err != nil {
+		return err
+	}
+	if IsSubdomain(p.Name) {
+		return sdkerrors.Wrap(ErrSubdomain, p.Name)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p SeizeNameProposal) String() string {
+	return fmt.Sprintf(`Seize Name Proposal:
+  Title:       %s
+  Description: %s
+  Name:        %s
+  New Owner:   %s
+`, p.Title, p.Description, p.Name, p.NewOwner)
+}
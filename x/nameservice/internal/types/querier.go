@@ -3,17 +3,25 @@ package types
 import (
 	"fmt"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Query endpoints supported by the nameservice querier
+// Query limits of the paginated queries
 const (
-// TODO: Describe query parameters, update <action> with your query
-// Query<Action>    = "<action>"
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+	// MaxScannedNames is the most names a page of the names query reads
+	// through, however few of them pass its filter
+	MaxScannedNames = 10000
 )
 
 // QueryResResolve Queries Result Payload for a resolve query
 type QueryResResolve struct {
-	Value string `json:"value"`
+	Value  string `json:"value"`
+	Type   string `json:"type,omitempty"`
+	Key    string `json:"key,omitempty"`
+	Expiry int64  `json:"expiry"`
 }
 
 // implement fmt.Stringer
@@ -29,14 +37,160 @@ func (n QueryResNames) String() string {
 	return strings.Join(n[:], "\n")
 }
 
+// QueryResNamesPage Queries Result Payload for a paginated names query
+type QueryResNamesPage struct {
+	Names      QueryResNames     `json:"names"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	Bundled    []QueryResBundled `json:"bundled,omitempty"`
+}
+
+// QueryResBundled pairs a name of a names query sold in a bundle with the
+// name leading the bundle
+type QueryResBundled struct {
+	Name   string `json:"name"`
+	Bundle string `json:"bundle"`
+}
+
+// implement fmt.Stringer
+func (p QueryResNamesPage) String() string {
+	names := make(QueryResNames, len(p.Names))
+	copy(names, p.Names)
+	for i, name := range names {
+		for _, bundled := range p.Bundled {
+			if bundled.Name == name {
+				names[i] = fmt.Sprintf("%s (bundle: %s)", name, bundled.Bundle)
+			}
+		}
+	}
+	if p.NextCursor == "" {
+		return names.String()
+	}
+	return fmt.Sprintf("%s\nnext: %s", names, p.NextCursor)
+}
+
+// QueryNamesByOwnerParams defines the params for the names-by-owner query
+type QueryNamesByOwnerParams struct {
+	Owner      sdk.AccAddress `json:"owner"`
+	StartAfter string         `json:"start_after"`
+	Limit      int            `json:"limit"`
+}
+
+// NewQueryNamesByOwnerParams creates a new instance of QueryNamesByOwnerParams
+func NewQueryNamesByOwnerParams(owner sdk.AccAddress, startAfter string, limit int) QueryNamesByOwnerParams {
+	return QueryNamesByOwnerParams{
+		Owner:      owner,
+		StartAfter: startAfter,
+		Limit:      limit,
+	}
+}
+
+// QueryNamesParams defines the params for the names query
+type QueryNamesParams struct {
+	StartAfter string     `json:"start_after"`
+	Limit      int        `json:"limit"`
+	Prefix     string     `json:"prefix"`
+	SaleTypes  []SaleType `json:"sale_types"`
+	Reverse    bool       `json:"reverse"`
+}
+
+// NewQueryNamesParams creates a new instance of QueryNamesParams
+func NewQueryNamesParams(startAfter string, limit int, prefix string, saleTypes []SaleType, reverse bool) QueryNamesParams {
+	return QueryNamesParams{
+		StartAfter: startAfter,
+		Limit:      limit,
+		Prefix:     prefix,
+		SaleTypes:  saleTypes,
+		Reverse:    reverse,
+	}
+}
+
+// MatchSaleType reports whether a sale type passes the sale type filter,
+// an empty filter matching every sale type
+func (p QueryNamesParams) MatchSaleType(saleType SaleType) bool {
+	if len(p.SaleTypes) == 0 {
+		return true
+	}
+	for _, t := range p.SaleTypes {
+		if t == saleType {
+			return true
+		}
+	}
+	return false
+}
+
+// PageLimit returns the number of names to return in a page
+func PageLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultQueryLimit
+	}
+	if limit > MaxQueryLimit {
+		return MaxQueryLimit
+	}
+	return limit
+}
+
 type QuerySaleStatus struct {
-	SaleType SaleType `json:"sale_type,omitempty"`
-	Price    string   `json:"price,omitempty"`
-	BidPrice string   `json:"bid_price,omitempty"`
+	SaleType        SaleType         `json:"sale_type,omitempty"`
+	Price           string           `json:"price,omitempty"`
+	BidPrice        string           `json:"bid_price,omitempty"`
+	ClearingPrice   string           `json:"clearing_price,omitempty"`
+	CurrentPrice    string           `json:"current_price,omitempty"`
+	FloorPrice      string           `json:"floor_price,omitempty"`
+	CommitEndHeight int64            `json:"commit_end_height,omitempty"`
+	EndHeight       int64            `json:"end_height,omitempty"`
+	Extensions      int64            `json:"extensions,omitempty"`
+	ReservePrice    string           `json:"reserve_price,omitempty"`
+	AllowedBuyers   []sdk.AccAddress `json:"allowed_buyers,omitempty"`
+	ListingExpiry   int64            `json:"listing_expiry,omitempty"`
+	Bundle          []string         `json:"bundle,omitempty"`
 }
 
 func (n QuerySaleStatus) String() string {
-	return fmt.Sprintf(`saleType: %ld,
+	return fmt.Sprintf(`saleType: %d,
 price: %s,
-lastBidPrice: %s`, n.SaleType, n.Price, n.BidPrice)
+lastBidPrice: %s,
+clearingPrice: %s,
+currentPrice: %s,
+floorPrice: %s,
+commitEndHeight: %d,
+endHeight: %d,
+extensions: %d,
+reservePrice: %s,
+allowedBuyers: %s,
+listingExpiry: %d,
+bundle: %s`, n.SaleType, n.Price, n.BidPrice, n.ClearingPrice, n.CurrentPrice, n.FloorPrice, n.CommitEndHeight, n.EndHeight, n.Extensions, n.ReservePrice, n.AllowedBuyers, n.ListingExpiry, n.Bundle)
+}
+
+// QueryResPriceQuote Queries Result Payload for a price quote query
+type QueryResPriceQuote struct {
+	Price   sdk.Coins `json:"price"`
+	Premium bool      `json:"premium"`
+}
+
+// implement fmt.Stringer
+func (q QueryResPriceQuote) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Price: %s
+Premium: %t`, q.Price, q.Premium))
+}
+
+// QueryResOffers Queries Result Payload for an offers query
+type QueryResOffers []Offer
+
+// implement fmt.Stringer
+func (o QueryResOffers) String() string {
+	offers := make([]string, len(o))
+	for i, offer := range o {
+		offers[i] = offer.String()
+	}
+	return strings.Join(offers, "\n\n")
+}
+
+// QueryResReverse Queries Result Payload for a reverse query
+type QueryResReverse struct {
+	Name string `json:"name"`
+}
+
+// implement fmt.Stringer
+func (r QueryResReverse) String() string {
+	return r.Name
 }
@@ -0,0 +1,191 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/bech32"
+)
+
+// Record types that can be set on a name
+const (
+	RecordTypeAddress     = "address"
+	RecordTypeText        = "text"
+	RecordTypeContentHash = "contenthash"
+	RecordTypeAlias       = "alias"
+)
+
+// Record length limits
+const (
+	MaxTextKeyLength     = 64
+	MaxTextValueLength   = 256
+	MaxContentHashLength = 64
+	// MaxKeyedRecords is the most address and text records a name holds
+	MaxKeyedRecords = 32
+)
+
+// AddressRecord is the address a name resolves to on a chain, keyed by the
+// bech32 prefix of that chain
+type AddressRecord struct {
+	Prefix  string `json:"prefix"`
+	Address string `json:"address"`
+}
+
+// TextRecord is an arbitrary key/value record of a name
+type TextRecord struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Records is the set of typed records a name resolves to
+type Records struct {
+	Addresses   []AddressRecord `json:"addresses,omitempty"`
+	Texts       []TextRecord    `json:"texts,omitempty"`
+	ContentHash string          `json:"content_hash,omitempty"`
+	Alias       string          `json:"alias,omitempty"`
+}
+
+// IsRecordTypeValid returns whether a record type is known
+func IsRecordTypeValid(recordType string) bool {
+	return recordType == RecordTypeAddress ||
+		recordType == RecordTypeText ||
+		recordType == RecordTypeContentHash ||
+		recordType == RecordTypeAlias
+}
+
+// IsRecordKeyed returns whether records of a type are keyed. A name holds a
+// single content hash and alias, but many address and text records.
+func IsRecordKeyed(recordType string) bool {
+	return recordType == RecordTypeAddress || recordType == RecordTypeText
+}
+
+// ValidateRecordKey checks the type and key identifying a record
+func ValidateRecordKey(recordType, key string) error {
+	if !IsRecordTypeValid(recordType) {
+		return fmt.Errorf("unknown record type: %s", recordType)
+	}
+	if IsRecordKeyed(recordType) && len(key) == 0 {
+		return fmt.Errorf("%s records require a key", recordType)
+	}
+	if !IsRecordKeyed(recordType) && len(key) != 0 {
+		return fmt.Errorf("%s records do not take a key", recordType)
+	}
+	if recordType == RecordTypeText && len(key) > MaxTextKeyLength {
+		return fmt.Errorf("text record key longer than %d", MaxTextKeyLength)
+	}
+	// A key is the last segment of the path a record is resolved at
+	if strings.Contains(key, "/") {
+		return fmt.Errorf("record key cannot contain '/': %s", key)
+	}
+	return nil
+}
+
+// ValidateRecord checks a record value against the rules of its type
+func ValidateRecord(recordType, key, value string) error {
+	if err := ValidateRecordKey(recordType, key); err != nil {
+		return err
+	}
+	if len(value) == 0 {
+		return fmt.Errorf("record value cannot be empty")
+	}
+
+	switch recordType {
+	case RecordTypeAddress:
+		hrp, _, err := bech32.DecodeAndConvert(value)
+		if err != nil {
+			return fmt.Errorf("invalid bech32 address %s: %s", value, err)
+		}
+		if hrp != key {
+			return fmt.Errorf("address %s does not match prefix %s", value, key)
+		}
+	case RecordTypeText:
+		if len(value) > MaxTextValueLength {
+			return fmt.Errorf("text record value longer than %d", MaxTextValueLength)
+		}
+	case RecordTypeContentHash:
+		bz, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+		if err != nil {
+			return fmt.Errorf("content hash must be hex encoded: %s", err)
+		}
+		if len(bz) > MaxContentHashLength {
+			return fmt.Errorf("content hash longer than %d bytes", MaxContentHashLength)
+		}
+	case RecordTypeAlias:
+		if strings.ContainsAny(value, " \t\n") {
+			return fmt.Errorf("alias target must be a name: %s", value)
+		}
+	}
+	return nil
+}
+
+// Get returns the value of a record
+func (r Records) Get(recordType, key string) (string, bool) {
+	switch recordType {
+	case RecordTypeAddress:
+		for _, record := range r.Addresses {
+			if record.Prefix == key {
+				return record.Address, true
+			}
+		}
+	case RecordTypeText:
+		for _, record := range r.Texts {
+			if record.Key == key {
+				return record.Value, true
+			}
+		}
+	case RecordTypeContentHash:
+		return r.ContentHash, r.ContentHash != ""
+	case RecordTypeAlias:
+		return r.Alias, r.Alias != ""
+	}
+	return "", false
+}
+
+// KeyedLen returns the number of address and text records
+func (r Records) KeyedLen() int {
+	return len(r.Addresses) + len(r.Texts)
+}
+
+// Set sets the value of a record, replacing the previous value if any
+func (r Records) Set(recordType, key, value string) Records {
+	r = r.Clear(recordType, key)
+	switch recordType {
+	case RecordTypeAddress:
+		r.Addresses = append(r.Addresses, AddressRecord{Prefix: key, Address: value})
+	case RecordTypeText:
+		r.Texts = append(r.Texts, TextRecord{Key: key, Value: value})
+	case RecordTypeContentHash:
+		r.ContentHash = value
+	case RecordTypeAlias:
+		r.Alias = value
+	}
+	return r
+}
+
+// Clear removes a record
+func (r Records) Clear(recordType, key string) Records {
+	switch recordType {
+	case RecordTypeAddress:
+		var addresses []AddressRecord
+		for _, record := range r.Addresses {
+			if record.Prefix != key {
+				addresses = append(addresses, record)
+			}
+		}
+		r.Addresses = addresses
+	case RecordTypeText:
+		var texts []TextRecord
+		for _, record := range r.Texts {
+			if record.Key != key {
+				texts = append(texts, record)
+			}
+		}
+		r.Texts = texts
+	case RecordTypeContentHash:
+		r.ContentHash = ""
+	case RecordTypeAlias:
+		r.Alias = ""
+	}
+	return r
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SealedBidCommitment returns the commitment of a sealed bid, the hex encoded
+// sha256 hash of the name, the bidder, the bid and a secret salt. Binding the
+// name and the bidder keeps a commitment from being replayed by someone else.
+func SealedBidCommitment(name string, buyer sdk.AccAddress, bid sdk.Coins, salt string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%s", name, buyer, bid, salt)))
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidateCommitment checks that a commitment is a hex encoded sha256 hash
+func ValidateCommitment(commitment string) error {
+	bz, err := hex.DecodeString(commitment)
+	if err != nil || len(bz) != sha256.Size {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "commitment must be a hex encoded sha256 hash")
+	}
+	return nil
+}
+
+// FindBid returns the index of the bid of a buyer, if any
+func (s SaleStatus) FindBid(buyer sdk.AccAddress) (int, bool) {
+	for i, bid := range s.Bids {
+		if bid.Buyer.Equals(buyer) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// IsCommitPhase returns whether sealed bids can be committed at the given height
+func (s SaleStatus) IsCommitPhase(height int64) bool {
+	return s.SaleType == SaleTypeSealedAuction && height <= s.CommitEndHeight
+}
+
+// IsRevealPhase returns whether sealed bids can be revealed at the given height
+func (s SaleStatus) IsRevealPhase(height int64) bool {
+	return s.SaleType == SaleTypeSealedAuction && height > s.CommitEndHeight && height < s.EndHeight
+}
+
+// HighestRevealedBid returns the index of the highest revealed bid reaching
+// the sale price, the earliest one winning ties
+func (s SaleStatus) HighestRevealedBid() (int, bool) {
+	winner := -1
+	for i, bid := range s.Bids {
+		if !bid.Revealed || !bid.Price.IsAllGTE(s.Price) {
+			continue
+		}
+		if winner < 0 || bid.Price.IsAllGT(s.Bids[winner].Price) {
+			winner = i
+		}
+	}
+	return winner, winner >= 0
+}
@@ -2,25 +2,26 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// MinNamePrice is Initial Starting Price for a name that was never previously owned
-var MinNamePrice = sdk.Coins{sdk.NewInt64Coin("nametoken", 1)}
-
 type SaleType = int
 
 const (
 	SaleTypeNotSale SaleType = iota
 	SaleTypeNormal
 	SaleTypeAuction
+	SaleTypeSealedAuction
+	SaleTypeSecondPriceAuction
+	SaleTypeDutchAuction
 )
 
-const (
-	AuctionFinishBlockInterval = 100
-)
+// SubdomainSeparator separates the label of a subdomain from its parent name
+const SubdomainSeparator = "."
 
 // Whois is a struct that contains all the metadata of a name
 type Whois struct {
@@ -28,12 +29,26 @@ type Whois struct {
 	Owner      sdk.AccAddress `json:"owner"`
 	Price      sdk.Coins      `json:"price"`
 	SaleStatus SaleStatus     `json:"saleStaus"`
+	Expiry     int64          `json:"expiry"`
+	Parent     string         `json:"parent,omitempty"`
+	Records    Records        `json:"records"`
 }
 
 type SaleStatus struct {
-	SaleType SaleType  `json:"sale_type"`
-	Price    sdk.Coins `json:"price"`
-	Bids     []Bid     `json:"bids,omitempty"`
+	SaleType        SaleType         `json:"sale_type"`
+	Price           sdk.Coins        `json:"price"`
+	Bids            []Bid            `json:"bids,omitempty"`
+	EndHeight       int64            `json:"end_height,omitempty"`
+	CommitEndHeight int64            `json:"commit_end_height,omitempty"` // last height at which sealed bids are committed
+	ClearingPrice   sdk.Coins        `json:"clearing_price,omitempty"`    // price paid by the winner of a settled second price auction
+	FloorPrice      sdk.Coins        `json:"floor_price,omitempty"`       // lowest price of a dutch auction, reached at its end height
+	StartHeight     int64            `json:"start_height,omitempty"`      // height at which the price of a dutch auction starts to fall
+	Extensions      int64            `json:"extensions,omitempty"`        // times the end of an auction has been pushed out by late bids
+	ReservePrice    sdk.Coins        `json:"reserve_price,omitempty"`     // lowest price an auction sells at
+	HideReserve     bool             `json:"hide_reserve,omitempty"`      // whether the reserve price is kept out of queries until the auction ends
+	AllowedBuyers   []sdk.AccAddress `json:"allowed_buyers,omitempty"`    // only buyers of a private normal sale
+	ListingExpiry   int64            `json:"listing_expiry,omitempty"`    // last height at which a normal sale is listed
+	Bundle          []string         `json:"bundle,omitempty"`            // other names sold along with the name, as one bundle
 }
 
 type Bid struct {
@@ -41,18 +56,228 @@ type Bid struct {
 	Price       sdk.Coins      `json:"price"`
 	BlockHeight int64          `json:"blockHeight"`
 	Timestamp   int64          `json:"timestamp,omitempty"`
+	Commitment  string         `json:"commitment,omitempty"` // hash of a sealed bid
+	Deposit     sdk.Coins      `json:"deposit,omitempty"`    // coins escrowed along with a sealed bid, or kept from an outbid open bid
+	Revealed    bool           `json:"revealed,omitempty"`   // whether a sealed bid has been revealed
+	Withdrawn   bool           `json:"withdrawn,omitempty"`  // whether an outbid bid was withdrawn from the fallback of the settlement
+	MaxPrice    sdk.Coins      `json:"max_price,omitempty"`  // hidden maximum up to which a proxy bid is raised
+}
+
+// Escrow returns the coins held for an open auction bid while it leads, which
+// is the maximum price of a proxy bid
+func (b Bid) Escrow() sdk.Coins {
+	if !b.MaxPrice.Empty() {
+		return b.MaxPrice
+	}
+	return b.Price
 }
 
 func IsSaleTypeValid(saleType SaleType) bool {
 	return saleType == SaleTypeNotSale ||
 		saleType == SaleTypeAuction ||
-		saleType == SaleTypeNormal
+		saleType == SaleTypeNormal ||
+		saleType == SaleTypeSealedAuction ||
+		saleType == SaleTypeSecondPriceAuction ||
+		saleType == SaleTypeDutchAuction
+}
+
+// SettlementPrice returns the price the leading bidder of an open auction
+// pays, which is its own bid except on a second price auction. There it pays
+// the runner-up bid, the highest one of another bidder, or the sale price when
+// nobody else bid, and at least the reserve price. It never exceeds the
+// leading bid.
+func (s SaleStatus) SettlementPrice() sdk.Coins {
+	if len(s.Bids) == 0 {
+		return nil
+	}
+	leading := s.Bids[len(s.Bids)-1]
+	if s.SaleType != SaleTypeSecondPriceAuction {
+		return leading.Price
+	}
+
+	price := s.Price
+	for i := len(s.Bids) - 2; i >= 0; i-- {
+		if !s.Bids[i].Buyer.Equals(leading.Buyer) {
+			price = s.Bids[i].Price
+			break
+		}
+	}
+	if !s.ReservePrice.Empty() && !price.IsAllGTE(s.ReservePrice) {
+		price = s.ReservePrice
+	}
+	if !leading.Price.IsAllGTE(price) {
+		return leading.Price
+	}
+	return price
+}
+
+// SettlementCandidates returns the indexes of the bids a sale settles with,
+// best first, so that the next one is tried when a bid fails to settle. On an
+// open auction these are the latest bid of each bidder meeting the reserve
+// price and not withdrawn, on a sealed bid auction the revealed bids reaching the sale price,
+// the earliest one first among equal bids.
+func (s SaleStatus) SettlementCandidates() []int {
+	var candidates []int
+	switch s.SaleType {
+	case SaleTypeAuction, SaleTypeSecondPriceAuction:
+		for i := len(s.Bids) - 1; i >= 0; i-- {
+			if s.Bids[i].Withdrawn || !s.MeetsReserve(s.Bids[i].Price) || s.hasLaterBid(i) {
+				continue
+			}
+			candidates = append(candidates, i)
+		}
+	case SaleTypeSealedAuction:
+		for i, bid := range s.Bids {
+			if bid.Revealed && bid.Price.IsAllGTE(s.Price) {
+				candidates = append(candidates, i)
+			}
+		}
+		sort.SliceStable(candidates, func(a, b int) bool {
+			return s.Bids[candidates[a]].Price.IsAllGT(s.Bids[candidates[b]].Price)
+		})
+	}
+	return candidates
+}
+
+// hasLaterBid returns whether the bidder of a bid bid again afterwards
+func (s SaleStatus) hasLaterBid(i int) bool {
+	for _, bid := range s.Bids[i+1:] {
+		if bid.Buyer.Equals(s.Bids[i].Buyer) {
+			return true
+		}
+	}
+	return false
+}
+
+// SettlementPriceOf returns the price an open auction settles at with the
+// given bid as the winning one, ignoring any later bid
+func (s SaleStatus) SettlementPriceOf(i int) sdk.Coins {
+	s.Bids = s.Bids[:i+1]
+	return s.SettlementPrice()
+}
+
+// HighestBid returns the highest bid known of a sale: the leading bid of an
+// open auction, or the sale price of a sealed bid auction whose bids are not
+// known before they are revealed
+func (s SaleStatus) HighestBid() sdk.Coins {
+	if s.SaleType == SaleTypeSealedAuction {
+		return s.Price
+	}
+	if len(s.Bids) == 0 {
+		return nil
+	}
+	return s.Bids[len(s.Bids)-1].Price
+}
+
+// IsBuyerAllowed returns whether a buyer may buy from the sale, which is
+// anyone unless the sale is private
+func (s SaleStatus) IsBuyerAllowed(buyer sdk.AccAddress) bool {
+	if len(s.AllowedBuyers) == 0 {
+		return true
+	}
+	for _, allowed := range s.AllowedBuyers {
+		if allowed.Equals(buyer) {
+			return true
+		}
+	}
+	return false
+}
+
+// BundleMatches returns whether the given names are the other names of the
+// bundle of a sale, in any order
+func (s SaleStatus) BundleMatches(names []string) bool {
+	if len(names) != len(s.Bundle) {
+		return false
+	}
+	for _, name := range names {
+		found := false
+		for _, member := range s.Bundle {
+			if member == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// MeetsReserve returns whether a bid reaches the reserve price, if any
+func (s SaleStatus) MeetsReserve(bid sdk.Coins) bool {
+	return s.ReservePrice.Empty() || bid.IsAllGTE(s.ReservePrice)
+}
+
+// Public returns the sale status as shown by queries, without a hidden
+// reserve price nor the maximum price of proxy bids. Hidden only means left
+// out of queries, the raw store still holds them.
+func (s SaleStatus) Public() SaleStatus {
+	if s.HideReserve {
+		s.ReservePrice = nil
+	}
+	if len(s.Bids) > 0 {
+		bids := make([]Bid, len(s.Bids))
+		for i, bid := range s.Bids {
+			bid.MaxPrice = nil
+			bids[i] = bid
+		}
+		s.Bids = bids
+	}
+	return s
+}
+
+// DutchPriceAt returns the price of a dutch auction at the given height,
+// falling from the sale price down to the floor price at the end height
+func (s SaleStatus) DutchPriceAt(height int64) sdk.Coins {
+	return DecayPrice(s.Price, s.FloorPrice, height-s.StartHeight, s.EndHeight-s.StartHeight)
+}
+
+// IsSubdomain returns whether a name has a parent name
+func IsSubdomain(name string) bool {
+	return strings.Contains(name, SubdomainSeparator)
+}
+
+// SubdomainName joins a label and its parent name into a subdomain name
+func SubdomainName(label, parent string) string {
+	return label + SubdomainSeparator + parent
+}
+
+// SplitSubdomain splits a subdomain name into its label and parent name
+func SplitSubdomain(name string) (label, parent string) {
+	parts := strings.SplitN(name, SubdomainSeparator, 2)
+	if len(parts) < 2 {
+		return name, ""
+	}
+	return parts[0], parts[1]
+}
+
+// ValidateName checks that a name holds no 0x00 byte, which separates a name
+// from what follows it in the subdomain and offer keys
+func ValidateName(name string) error {
+	if strings.IndexByte(name, 0x00) >= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot contain a 0x00 byte")
+	}
+	return nil
+}
+
+// NewSubdomainWhois returns a new Whois for a subdomain issued under a parent
+// name, which is never on sale
+func NewSubdomainWhois(parent string, owner sdk.AccAddress, value string) Whois {
+	return Whois{
+		Value:  value,
+		Owner:  owner,
+		Parent: parent,
+		SaleStatus: SaleStatus{
+			SaleType: SaleTypeNotSale,
+		},
+	}
 }
 
 // NewWhois returns a new Whois with the minprice as the price
-func NewWhois() Whois {
+func NewWhois(minPrice sdk.Coins) Whois {
 	return Whois{
-		Price: MinNamePrice,
+		Price: minPrice,
 		SaleStatus: SaleStatus{
 			SaleType: SaleTypeNormal,
 		},
@@ -63,5 +288,46 @@ func NewWhois() Whois {
 func (w Whois) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Owner: %s
 Value: %s
-Price: %s`, w.Owner, w.Value, w.Price))
+Price: %s
+Expiry: %d
+Parent: %s`, w.Owner, w.Value, w.Price, w.Expiry, w.Parent))
+}
+
+// DecayPrice returns the price found by decaying linearly, denom by denom,
+// from the start price down to the floor price over the given duration
+func DecayPrice(start, floor sdk.Coins, elapsed, duration int64) sdk.Coins {
+	if elapsed >= duration || duration <= 0 {
+		return floor
+	}
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	price := sdk.NewCoins()
+	for _, coin := range start.Add(floor...) {
+		startAmt, floorAmt := start.AmountOf(coin.Denom), floor.AmountOf(coin.Denom)
+		if startAmt.LTE(floorAmt) {
+			price = price.Add(sdk.NewCoin(coin.Denom, floorAmt))
+			continue
+		}
+		decayed := startAmt.Sub(floorAmt).MulRaw(duration - elapsed).QuoRaw(duration)
+		price = price.Add(sdk.NewCoin(coin.Denom, floorAmt.Add(decayed)))
+	}
+	return price
+}
+
+// ResolvesTo returns whether the name resolves to an account address, either
+// through the address record for the account prefix or through its value
+func (w Whois) ResolvesTo(addr sdk.AccAddress) bool {
+	prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	if resolved, found := w.Records.Get(RecordTypeAddress, prefix); found {
+		return resolved == addr.String()
+	}
+	return w.Value == addr.String()
+}
+
+// IsExpired returns whether the registration term of the name is over at the
+// given height
+func (w Whois) IsExpired(height int64) bool {
+	return w.Expiry > 0 && w.Expiry <= height
 }
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func testCoins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("nametoken", amount))
+}
+
+func testBid(buyer string, price int64) Bid {
+	return Bid{Buyer: sdk.AccAddress(buyer), Price: testCoins(price)}
+}
+
+func TestSettlementPrice(t *testing.T) {
+	cases := []struct {
+		name     string
+		saleType SaleType
+		reserve  int64
+		bids     []Bid
+		// of is the index of the bid leading the settlement
+		of       int
+		expected sdk.Coins
+	}{
+		{"first price auction pays the leading bid", SaleTypeAuction, 0,
+			[]Bid{testBid("alice", 100), testBid("bob", 150)}, 1, testCoins(150)},
+		{"runner-up bid of another bidder", SaleTypeSecondPriceAuction, 0,
+			[]Bid{testBid("alice", 100), testBid("bob", 150)}, 1, testCoins(100)},
+		{"bidder outbidding itself skipped for the runner-up", SaleTypeSecondPriceAuction, 0,
+			[]Bid{testBid("alice", 100), testBid("bob", 120), testBid("bob", 150)}, 2, testCoins(100)},
+		{"sale price without another bidder", SaleTypeSecondPriceAuction, 0,
+			[]Bid{testBid("bob", 120), testBid("bob", 150)}, 1, testCoins(50)},
+		{"raised to the reserve price", SaleTypeSecondPriceAuction, 130,
+			[]Bid{testBid("alice", 100), testBid("bob", 150)}, 1, testCoins(130)},
+		{"capped at the leading bid", SaleTypeSecondPriceAuction, 200,
+			[]Bid{testBid("alice", 100), testBid("bob", 150)}, 1, testCoins(150)},
+		{"fallback bid paying the runner-up before it", SaleTypeSecondPriceAuction, 0,
+			[]Bid{testBid("alice", 100), testBid("bob", 150), testBid("carol", 200)}, 1, testCoins(100)},
+		{"first fallback bid paying the sale price", SaleTypeSecondPriceAuction, 0,
+			[]Bid{testBid("alice", 100), testBid("bob", 150)}, 0, testCoins(50)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			status := SaleStatus{SaleType: tc.saleType, Price: testCoins(50), Bids: tc.bids}
+			if tc.reserve != 0 {
+				status.ReservePrice = testCoins(tc.reserve)
+			}
+			require.Equal(t, tc.expected, status.SettlementPriceOf(tc.of))
+			if tc.of == len(tc.bids)-1 {
+				require.Equal(t, tc.expected, status.SettlementPrice())
+			}
+		})
+	}
+
+	require.Nil(t, SaleStatus{SaleType: SaleTypeSecondPriceAuction, Price: testCoins(50)}.SettlementPrice())
+}
+
+func TestDecayPrice(t *testing.T) {
+	cases := []struct {
+		name              string
+		start, floor      sdk.Coins
+		elapsed, duration int64
+		expected          sdk.Coins
+	}{
+		{"start price before any block elapsed", testCoins(1000), testCoins(100), 0, 100, testCoins(1000)},
+		{"linear decay", testCoins(1000), testCoins(100), 25, 100, testCoins(775)},
+		{"negative elapsed taken as the start", testCoins(1000), testCoins(100), -10, 100, testCoins(1000)},
+		{"floor price once the duration elapsed", testCoins(1000), testCoins(100), 100, 100, testCoins(100)},
+		{"floor price past the duration", testCoins(1000), testCoins(100), 150, 100, testCoins(100)},
+		{"floor price without a duration", testCoins(1000), testCoins(100), 0, 0, testCoins(100)},
+		{"start at the floor price", testCoins(100), testCoins(100), 50, 100, testCoins(100)},
+		{"start below the floor price", testCoins(50), testCoins(100), 50, 100, testCoins(100)},
+		{
+			"every denom decayed on its own",
+			sdk.NewCoins(sdk.NewInt64Coin("nametoken", 1000), sdk.NewInt64Coin("stake", 200)),
+			sdk.NewCoins(sdk.NewInt64Coin("nametoken", 100), sdk.NewInt64Coin("stake", 300)),
+			50, 100,
+			sdk.NewCoins(sdk.NewInt64Coin("nametoken", 550), sdk.NewInt64Coin("stake", 300)),
+		},
+		{
+			"denom only in the start price decayed to zero",
+			sdk.NewCoins(sdk.NewInt64Coin("nametoken", 1000), sdk.NewInt64Coin("stake", 200)),
+			testCoins(100),
+			50, 100,
+			sdk.NewCoins(sdk.NewInt64Coin("nametoken", 550), sdk.NewInt64Coin("stake", 100)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, DecayPrice(tc.start, tc.floor, tc.elapsed, tc.duration))
+		})
+	}
+}
+
+func TestDutchPriceAt(t *testing.T) {
+	status := SaleStatus{
+		SaleType:    SaleTypeDutchAuction,
+		Price:       testCoins(1000),
+		FloorPrice:  testCoins(100),
+		StartHeight: 10,
+		EndHeight:   110,
+	}
+	for height, expected := range map[int64]int64{5: 1000, 10: 1000, 60: 550, 109: 109, 110: 100, 200: 100} {
+		require.Equal(t, testCoins(expected), status.DutchPriceAt(height), "price at height %d", height)
+	}
+}
+
+// TestValidateName checks that names holding the 0x00 byte separating them in
+// store keys are rejected wherever a name or an offer on it is created
+func TestValidateName(t *testing.T) {
+	owner := sdk.AccAddress("owner")
+	for _, tc := range []struct {
+		name  string
+		valid bool
+	}{
+		{"alice", true},
+		{"alice.bob", true},
+		{"alice\x00", false},
+		{"al\x00ice", false},
+	} {
+		require.Equal(t, tc.valid, ValidateName(tc.name) == nil, "%q", tc.name)
+		require.Equal(t, tc.valid, NewMsgBuyName(tc.name, testCoins(10), owner).ValidateBasic() == nil, "buy %q", tc.name)
+		require.Equal(t, tc.valid, NewMsgMakeOffer(tc.name, testCoins(10), 20, owner).ValidateBasic() == nil, "offer on %q", tc.name)
+	}
+
+	// A subdomain with the 0x00 byte in its label would share the keys of
+	// the subdomains of another parent
+	require.Equal(t, SubdomainKey("a", "\x00b"), SubdomainKey("a\x00", "b"))
+	require.Error(t, NewMsgCreateSubdomain("a", "\x00b", "value", owner, owner).ValidateBasic())
+	require.Error(t, NewReserveNameProposal("title", "description", []string{"a\x00"}).ValidateBasic())
+}
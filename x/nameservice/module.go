@@ -105,7 +105,8 @@ func (am AppModule) BeginBlock(ctx sdk.Context, block abci.RequestBeginBlock) {
 	am.keeper.FinishAuctions(ctx, height)
 }
 
-func (am AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,56 @@
+package nameservice
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/lpy-neo/nameservice/x/nameservice/internal/types"
+)
+
+// NewProposalHandler creates a governance handler for the nameservice proposals
+func NewProposalHandler(keeper Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.ReserveNameProposal:
+			return handleReserveNameProposal(ctx, keeper, c)
+		case types.SeizeNameProposal:
+			return handleSeizeNameProposal(ctx, keeper, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized nameservice proposal content type: %T", c)
+		}
+	}
+}
+
+// Handle a proposal to reserve names
+func handleReserveNameProposal(ctx sdk.Context, keeper Keeper, p types.ReserveNameProposal) error {
+	for _, name := range p.Names {
+		keeper.SetReserved(ctx, name)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeNameReserved,
+				sdk.NewAttribute(types.AttributeKeyName, name),
+				types.NewHeightAttribute(ctx.BlockHeight()),
+			),
+		)
+	}
+	return nil
+}
+
+// Handle a proposal to seize a name
+func handleSeizeNameProposal(ctx sdk.Context, keeper Keeper, p types.SeizeNameProposal) error {
+	prevOwner := keeper.GetOwner(ctx, p.Name)
+	if err := keeper.SeizeName(ctx, p.Name, p.NewOwner); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameSeized,
+			sdk.NewAttribute(types.AttributeKeyName, p.Name),
+			sdk.NewAttribute(types.AttributeKeySeller, prevOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, p.NewOwner.String()),
+			types.NewHeightAttribute(ctx.BlockHeight()),
+		),
+	)
+	return nil
+}